@@ -6,8 +6,10 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"time"
 
 	"makly/hangman/internal/application"
+	"makly/hangman/internal/domain"
 	"makly/hangman/internal/infrastructure"
 	"makly/hangman/pkg/climenu"
 
@@ -32,7 +34,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	logFile, err := os.OpenFile(absLogFilePath, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o666)
+	logFile, err := os.OpenFile(absLogFilePath, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
 	if err != nil {
 		fmt.Println("Error opening log file", err)
 		os.Exit(1)
@@ -41,8 +43,18 @@ func main() {
 	logger := slog.New(slog.NewJSONHandler(logFile, &slog.HandlerOptions{AddSource: true}))
 	slog.SetDefault(logger)
 
+	// Secret words and hints are redacted unless logSecrets is explicitly enabled for debugging
+	if viper.GetBool("logSecrets") {
+		domain.SetLogSecrets(true)
+		slog.Warn("Secret words and hints are written to the log")
+	}
+
 	// Initialize game
-	category, difficulty, maxMistakes, err := infrastructure.Init(viper.GetString("defaultSamplePath"), viper.GetString("jsonSchemaPath"))
+	settings, err := infrastructure.Init(
+		viper.GetString("defaultSamplePath"),
+		viper.GetString("jsonSchemaPath"),
+		viper.GetString("savePath"),
+	)
 	if err != nil {
 		var exitErr *climenu.ExitError
 		if errors.As(err, &exitErr) {
@@ -55,12 +67,16 @@ func main() {
 		os.Exit(1)
 	}
 
-	inputer := infrastructure.NewConsoleInput()
-	outputer := infrastructure.NewConsoleOutput()
-
 	// Run game session
-	randDefault := &application.RandomDefault{}
-	if err := application.RunGameSession(category, difficulty, maxMistakes, inputer, outputer, randDefault); err != nil {
+	if err := runSession(settings); err != nil {
+		var exitErr *climenu.ExitError
+		if errors.As(err, &exitErr) {
+			slog.Info("Game session ended", slog.String("reason", err.Error()))
+			logFile.Close()
+
+			return
+		}
+
 		slog.Error("Game session error", slog.Any("error", err))
 		logFile.Close()
 		os.Exit(1)
@@ -69,3 +85,232 @@ func main() {
 	slog.Info("Game session ended", slog.String("reason", "success"))
 	logFile.Close()
 }
+
+func hotSeatSettings(settings *infrastructure.Settings, gameSettings *application.GameSettings) *application.HotSeatSettings {
+	return &application.HotSeatSettings{
+		Category:         gameSettings.Category,
+		Difficulty:       gameSettings.Difficulty,
+		Levels:           gameSettings.Levels,
+		MaxMistakes:      gameSettings.MaxMistakes,
+		WordGuessPenalty: gameSettings.WordGuessPenalty,
+		Players:          settings.Players,
+		MistakesRule:     settings.MistakesRule,
+		HintPolicy:       gameSettings.HintPolicy,
+		PowerUps:         gameSettings.PowerUps,
+		Random:           gameSettings.Random,
+	}
+}
+
+func runSession(settings *infrastructure.Settings) (err error) {
+	wordGuessPenalty := viper.GetInt("wordGuessPenalty")
+	if wordGuessPenalty < 1 {
+		slog.Warn("Invalid wordGuessPenalty value, set default value", slog.Int("wordGuessPenalty", wordGuessPenalty))
+		wordGuessPenalty = domain.DefaultWordGuessPenalty
+	}
+
+	outputer := infrastructure.NewConsoleOutput()
+	profile := loadProfileStore(settings)
+
+	if settings.Command != "" && settings.Command != infrastructure.DailyCommand {
+		return runCommand(settings, wordGuessPenalty, outputer, profile)
+	}
+
+	inputer := infrastructure.NewConsoleInput(settings.Alphabet)
+	saver := infrastructure.NewFileGameSaver(viper.GetString("savePath"), viper.GetString("recordsDir"), profile)
+
+	hintPolicies := loadHintPolicies(settings)
+	powerUps := loadPowerUps()
+	timing := &application.Timing{Limits: settings.TimeLimits, Clock: domain.SystemClock{}}
+
+	randomizer := loadRandomizer(settings, profile)
+	gameSettings := &application.GameSettings{
+		Category:         settings.Category,
+		Difficulty:       settings.Difficulty,
+		Levels:           settings.Levels,
+		MaxMistakes:      settings.MaxMistakes,
+		WordGuessPenalty: wordGuessPenalty,
+		HintPolicy:       hintPolicies.For(settings.Difficulty),
+		Tags:             settings.Tags,
+		PowerUps:         powerUps,
+		Practice:         settings.Practice,
+		Opponent:         opponentWords(settings),
+		Random:           settings.Random,
+		Timing:           timing,
+	}
+
+	switch {
+	case settings.Command == infrastructure.DailyCommand:
+		return runDaily(settings, wordGuessPenalty, hintPolicies, inputer, outputer, profile, saver)
+	case settings.SavedGame != nil:
+		return application.ResumeGameSession(settings.SavedGame, settings.Random, inputer, outputer, saver)
+	case settings.IsHotSeat():
+		return application.RunHotSeatSession(hotSeatSettings(settings, gameSettings), inputer, outputer, randomizer, saver)
+	case settings.Evil:
+		return application.RunEvilGameSession(gameSettings, inputer, outputer, randomizer, saver)
+	case settings.IsMatch():
+		_, err = application.RunMatch(&application.MatchSettings{
+			Rounds:           settings.Rounds,
+			Categories:       settings.Categories,
+			Category:         settings.Category,
+			Difficulty:       settings.Difficulty,
+			Levels:           settings.Levels,
+			MaxMistakes:      settings.MaxMistakes,
+			WordGuessPenalty: wordGuessPenalty,
+			HintPolicies:     hintPolicies,
+			PowerUps:         powerUps,
+			Timing:           timing,
+			Recorder:         saver,
+			Random:           settings.Random,
+		}, inputer, outputer, randomizer)
+
+		return err
+	default:
+		return application.RunGameSession(gameSettings, inputer, outputer, randomizer, saver)
+	}
+}
+
+func runCommand(
+	settings *infrastructure.Settings, wordGuessPenalty int, outputer *infrastructure.ConsoleOutput, profile *infrastructure.ProfileStore,
+) (err error) {
+	// Words are solved and simulated within the maxMistakes of their levels unless -maxmistakes is set
+	switch settings.Command {
+	case infrastructure.SolveCommand:
+		results := application.SolveCollection(settings.Categories, settings.Levels, settings.MaxMistakes, wordGuessPenalty)
+		outputer.ShowSolveReport(results, settings.Levels)
+
+		return nil
+	case infrastructure.SimulateCommand:
+		report := application.SimulateCollection(settings.Categories, &application.SimulationSettings{
+			Runs:             settings.Runs,
+			MaxMistakes:      settings.MaxMistakes,
+			WordGuessPenalty: wordGuessPenalty,
+			Levels:           settings.Levels,
+		}, settings.Random)
+
+		return infrastructure.WriteSimulationReport(os.Stdout, report, settings.Format)
+	case infrastructure.ReplayCommand:
+		return application.RunReplay(settings.SavedGame, outputer, domain.SystemClock{})
+	case infrastructure.StatsCommand:
+		if profile == nil {
+			return fmt.Errorf("profile %q is not available", settings.Profile)
+		}
+
+		playerProfile, err := profile.LoadProfile()
+		if err != nil {
+			return fmt.Errorf("load profile: %w", err)
+		}
+
+		outputer.ShowStats(playerProfile, settings.Levels)
+
+		return nil
+	default:
+		return fmt.Errorf("unknown command %q", settings.Command)
+	}
+}
+
+func runDaily(
+	settings *infrastructure.Settings,
+	wordGuessPenalty int,
+	hintPolicies *domain.HintPolicies,
+	inputer domain.GameInputer,
+	outputer domain.GameOutputer,
+	profile *infrastructure.ProfileStore,
+	recorder application.GameRecorder,
+) (err error) {
+	if profile == nil {
+		return fmt.Errorf("daily challenge needs the profile %q to keep the result", settings.Profile)
+	}
+
+	return application.RunDailyChallenge(&application.DailySettings{
+		Date:             time.Now().Format(time.DateOnly),
+		CollectionHash:   settings.CollectionHash,
+		Categories:       settings.Categories,
+		Levels:           settings.Levels,
+		MaxMistakes:      settings.MaxMistakes,
+		WordGuessPenalty: wordGuessPenalty,
+		HintPolicies:     hintPolicies,
+	}, inputer, outputer, profile, recorder)
+}
+
+// loadProfileStore returns nil when the profile can not be kept, then games are played without it.
+// opponentWords are the words known to the solver, it knows the whole collection like in the solve command.
+func opponentWords(settings *infrastructure.Settings) []domain.Word {
+	if !settings.Opponent {
+		return nil
+	}
+
+	return application.CollectionWords(settings.Categories)
+}
+
+func loadProfileStore(settings *infrastructure.Settings) *infrastructure.ProfileStore {
+	profile, err := infrastructure.NewProfileStore(settings.Profile)
+	if err != nil {
+		slog.Warn("Profile is not available", slog.String("profile", settings.Profile), slog.Any("error", err))
+		return nil
+	}
+
+	return profile
+}
+
+// loadRandomizer chooses words by the configured repeat policy, words may repeat when there is no profile to keep them.
+func loadRandomizer(settings *infrastructure.Settings, profile *infrastructure.ProfileStore) application.WordRandomizer {
+	if settings.SetterWord {
+		return infrastructure.NewSecretWordRandomizer(infrastructure.NewKeyboardSecretReader())
+	}
+
+	policy := domain.DefaultRepeatPolicy
+
+	if viper.IsSet("wordRepeat") {
+		var err error
+
+		policy, err = domain.ParseRepeatPolicy(viper.GetString("wordRepeat"))
+		if err != nil {
+			slog.Warn("Invalid word repeat policy, set default value", slog.Any("error", err))
+			policy = domain.DefaultRepeatPolicy
+		}
+	}
+
+	if profile == nil || policy.Mode == domain.AnyWord {
+		return application.NewWeightedRandomizer(settings.Tags, settings.Random)
+	}
+
+	slog.Info("Word repeat policy loaded", slog.String("policy", policy.String()))
+
+	return application.NewHistoryRandomizer(
+		profile, settings.CollectionPath, settings.Levels, policy, settings.Tags, settings.Random,
+	)
+}
+
+// loadHintPolicies reads the hint policies from the config, the -hints flag overrides them for every difficulty.
+func loadHintPolicies(settings *infrastructure.Settings) *domain.HintPolicies {
+	if settings.HintPolicy != nil {
+		return &domain.HintPolicies{Default: settings.HintPolicy}
+	}
+
+	hintPolicies, err := infrastructure.ParseHintPolicies(
+		viper.GetString("hintPolicy"), viper.GetStringMapString("hintPolicies"), settings.Levels,
+	)
+	if err != nil {
+		slog.Warn("Invalid hint policies, set default value", slog.Any("error", err))
+		return &domain.HintPolicies{Default: domain.DefaultHintPolicy}
+	}
+
+	slog.Info("Hint policies loaded", slog.Any("hint policies", hintPolicies))
+
+	return hintPolicies
+}
+
+func loadPowerUps() domain.PowerUps {
+	powerUps := domain.PowerUps{Letters: viper.GetInt("letterPurchases"), LetterCost: domain.DefaultLetterCost}
+
+	if viper.IsSet("letterCost") {
+		powerUps.LetterCost = viper.GetInt("letterCost")
+	}
+
+	if powerUps.Letters < 0 || powerUps.LetterCost < 0 {
+		slog.Warn("Invalid power-ups, set default value", slog.Any("power-ups", powerUps))
+		powerUps = domain.PowerUps{Letters: 0, LetterCost: domain.DefaultLetterCost}
+	}
+
+	return powerUps
+}
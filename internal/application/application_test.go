@@ -1,9 +1,14 @@
 package application_test
 
 import (
+	"context"
 	"io"
 	"log"
+	"math/rand/v2"
+	"slices"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -20,16 +25,15 @@ func TestChoiceDifficulty(t *testing.T) {
 	const iterations = 1000
 
 	difficultyCount := make(map[domain.Difficulty]int)
+	random := application.NewRandom(1)
 
 	for i := 0; i < iterations; i++ {
-		difficulty, err := application.ChoiceDifficulty()
-
-		assert.NoError(t, err)
+		difficulty := application.ChoiceDifficulty(domain.DefaultDifficultyLevels, random)
 
 		difficultyCount[difficulty]++
 	}
 
-	for i := 0; i < int(domain.DifficultyCount); i++ {
+	for i := range domain.DefaultDifficultyLevels.Count() {
 		assert.Greater(t, difficultyCount[domain.Difficulty(i)], 0, "Difficulty %d was never chosen", i)
 	}
 }
@@ -53,9 +57,10 @@ func TestChoiceCategory(t *testing.T) {
 	}
 
 	categoryCount := make(map[string]int)
+	random := application.NewRandom(1)
 
 	for i := 0; i < iterations; i++ {
-		category, err := application.ChoiceCategory(categories)
+		category, err := application.ChoiceCategory(categories, random)
 
 		assert.NoError(t, err)
 
@@ -67,16 +72,50 @@ func TestChoiceCategory(t *testing.T) {
 	}
 }
 
+func TestNewRandom(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	categories := []domain.Category{
+		{Name: "Animals", WordsByLevel: [][]domain.Word{{{Word: "ant"}, {Word: "bee"}, {Word: "cat"}, {Word: "dog"}}}},
+		{Name: "Fruits", WordsByLevel: [][]domain.Word{{{Word: "fig"}, {Word: "kiwi"}, {Word: "lime"}, {Word: "pear"}}}},
+	}
+
+	session := func(seed uint64) (choices []string) {
+		random := application.NewRandom(seed)
+		randomizer := application.NewWeightedRandomizer(domain.TagFilter{}, random)
+
+		for range 10 {
+			category, err := application.ChoiceCategory(categories, random)
+			assert.NoError(t, err)
+
+			word, err := randomizer.ChoiceWord(category, domain.EasyDifficulty)
+			assert.NoError(t, err)
+
+			difficulty := application.ChoiceDifficulty(domain.DefaultDifficultyLevels, random)
+			choices = append(choices, difficulty.String(), category.Name, word.Word)
+		}
+
+		return choices
+	}
+
+	// Sessions with the same seed make the same choices
+	assert.Equal(t, session(42), session(42))
+	assert.NotEqual(t, session(42), session(43))
+
+	_, err := application.ChoiceCategory(nil, application.NewRandom(1))
+	assert.Error(t, err)
+}
+
 func TestChoiceWord(t *testing.T) {
 	log.SetOutput(io.Discard)
 
-	rd := &application.RandomDefault{}
+	rd := application.NewWeightedRandomizer(domain.TagFilter{}, application.NewRandom(1))
 
 	const iterations = 1000
 
 	category := &domain.Category{
 		Name: "Category",
-		EasyWords: []domain.Word{
+		WordsByLevel: [][]domain.Word{{
 			{Word: "easy1", Hint: "hint_easy1"},
 			{Word: "easy2", Hint: "hint_easy2"},
 			{Word: "easy3", Hint: "hint_easy3"},
@@ -97,8 +136,7 @@ func TestChoiceWord(t *testing.T) {
 			{Word: "easy18", Hint: "hint_easy18"},
 			{Word: "easy19", Hint: "hint_easy19"},
 			{Word: "easy20", Hint: "hint_easy20"},
-		},
-		MediumWords: []domain.Word{
+		}, {
 			{Word: "medium1", Hint: "hint_medium1"},
 			{Word: "medium2", Hint: "hint_medium2"},
 			{Word: "medium3", Hint: "hint_medium3"},
@@ -119,8 +157,7 @@ func TestChoiceWord(t *testing.T) {
 			{Word: "medium18", Hint: "hint_medium18"},
 			{Word: "medium19", Hint: "hint_medium19"},
 			{Word: "medium20", Hint: "hint_medium20"},
-		},
-		HardWords: []domain.Word{
+		}, {
 			{Word: "hard1", Hint: "hint_hard1"},
 			{Word: "hard2", Hint: "hint_hard2"},
 			{Word: "hard3", Hint: "hint_hard3"},
@@ -141,7 +178,7 @@ func TestChoiceWord(t *testing.T) {
 			{Word: "hard18", Hint: "hint_hard18"},
 			{Word: "hard19", Hint: "hint_hard19"},
 			{Word: "hard20", Hint: "hint_hard20"},
-		},
+		}},
 	}
 
 	wordCount := make(map[string]int)
@@ -154,7 +191,7 @@ func TestChoiceWord(t *testing.T) {
 		wordCount[word.Word]++
 	}
 
-	for _, word := range category.EasyWords {
+	for _, word := range category.WordsByLevel[domain.EasyDifficulty] {
 		assert.Greater(t, wordCount[word.Word], 0, "Word %s was never chosen", word.Word)
 	}
 
@@ -168,7 +205,7 @@ func TestChoiceWord(t *testing.T) {
 		wordCount[word.Word]++
 	}
 
-	for _, word := range category.MediumWords {
+	for _, word := range category.WordsByLevel[domain.MediumDifficulty] {
 		assert.Greater(t, wordCount[word.Word], 0, "Word %s was never chosen", word.Word)
 	}
 
@@ -182,33 +219,930 @@ func TestChoiceWord(t *testing.T) {
 		wordCount[word.Word]++
 	}
 
-	for _, word := range category.HardWords {
+	for _, word := range category.WordsByLevel[domain.HardDifficulty] {
 		assert.Greater(t, wordCount[word.Word], 0, "Word %s was never chosen", word.Word)
 	}
 }
 
+func TestWeightedRandomizer(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+	category := &domain.Category{
+		Name: "Animals",
+		WordsByLevel: [][]domain.Word{{
+			{Word: "cat", Tags: []string{"mammal"}, Weight: 0.5},
+			{Word: "dog", Tags: []string{"mammal"}, Weight: 4},
+			{Word: "okapi", Tags: []string{"mammal", "obscure"}, Weight: 100},
+			{Word: "ant"},
+		}},
+	}
+
+	filter, err := domain.ParseTagFilter("mammal+!obscure")
+	assertInstance.NoError(err)
+
+	randomizer := application.NewWeightedRandomizer(filter, application.NewRandom(1))
+	counts := make(map[string]int)
+
+	for range 1000 {
+		word, err := randomizer.ChoiceWord(category, domain.EasyDifficulty)
+		assertInstance.NoError(err)
+
+		counts[word.Word]++
+	}
+
+	assertInstance.Len(counts, 2)
+	assertInstance.Greater(counts["dog"], counts["cat"])
+	assertInstance.Positive(counts["cat"])
+
+	// No word of the list passes the filter
+	filter, err = domain.ParseTagFilter("bird")
+	assertInstance.NoError(err)
+
+	_, err = application.NewWeightedRandomizer(filter, application.NewRandom(1)).ChoiceWord(category, domain.EasyDifficulty)
+
+	var categoryErr *domain.BadCategoryError
+
+	assertInstance.ErrorAs(err, &categoryErr)
+}
+
+func TestHistoryRandomizer(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+	category := &domain.Category{
+		Name:         "Animals",
+		WordsByLevel: [][]domain.Word{{{Word: "ant"}, {Word: "bee"}, {Word: "cat"}}},
+	}
+
+	history := &domain.WordHistory{}
+	mockStore := &applicationMocks.WordHistoryStore{}
+	mockStore.On("LoadWordHistory", "collection.json").Return(history, nil)
+	mockStore.On("SaveWordHistory", "collection.json", history).Return(nil)
+
+	randomizer := application.NewHistoryRandomizer(
+		mockStore, "collection.json", domain.DefaultDifficultyLevels, domain.RepeatPolicy{Mode: domain.ShuffledDeck}, domain.TagFilter{},
+		application.NewRandom(1),
+	)
+	played := make(map[string]bool)
+
+	for range category.WordsByLevel[domain.EasyDifficulty] {
+		word, err := randomizer.ChoiceWord(category, domain.EasyDifficulty)
+		assertInstance.NoError(err)
+		assertInstance.False(played[word.Word], word.Word)
+
+		played[word.Word] = true
+	}
+
+	assertInstance.Len(history.Recent, len(category.WordsByLevel[domain.EasyDifficulty]))
+	mockStore.AssertNumberOfCalls(t, "SaveWordHistory", len(category.WordsByLevel[domain.EasyDifficulty]))
+
+	_, err := randomizer.ChoiceWord(category, domain.HardDifficulty)
+	assertInstance.Error(err)
+
+	// Words are still chosen when the history can not be loaded
+	mockStore = &applicationMocks.WordHistoryStore{}
+	mockStore.On("LoadWordHistory", "collection.json").Return(nil, assert.AnError)
+	mockStore.On("SaveWordHistory", "collection.json", mock.Anything).Return(assert.AnError)
+
+	randomizer = application.NewHistoryRandomizer(
+		mockStore, "collection.json", domain.DefaultDifficultyLevels, domain.RepeatPolicy{Mode: domain.AvoidRecent, Recent: 2},
+		domain.TagFilter{}, application.NewRandom(1),
+	)
+	_, err = randomizer.ChoiceWord(category, domain.EasyDifficulty)
+	assertInstance.NoError(err)
+}
+
+func TestRunDailyChallenge(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+	categories := []domain.Category{
+		{Name: "Animals", WordsByLevel: [][]domain.Word{{{Word: "ant"}, {Word: "bee"}}, nil, {{Word: "cat"}}}},
+		{Name: "Fruits", WordsByLevel: [][]domain.Word{nil, {{Word: "fig"}, {Word: "kiwi"}}}},
+	}
+	settings := &application.DailySettings{
+		Date:             "2026-10-18",
+		CollectionHash:   "hash",
+		Categories:       categories,
+		MaxMistakes:      6,
+		WordGuessPenalty: domain.DefaultWordGuessPenalty,
+	}
+
+	// Everyone gets the same word on the same day
+	randomizer := application.NewDailyRandomizer(settings.Date, settings.CollectionHash, domain.DefaultDifficultyLevels)
+	category, difficulty, err := randomizer.ChoiceList(categories)
+	assertInstance.NoError(err)
+
+	word, err := randomizer.ChoiceWord(category, difficulty)
+	assertInstance.NoError(err)
+
+	for range 10 {
+		randomizer = application.NewDailyRandomizer(settings.Date, settings.CollectionHash, domain.DefaultDifficultyLevels)
+		sameCategory, sameDifficulty, _ := randomizer.ChoiceList(categories)
+		sameWord, _ := randomizer.ChoiceWord(sameCategory, sameDifficulty)
+
+		assertInstance.Equal(category, sameCategory)
+		assertInstance.Equal(difficulty, sameDifficulty)
+		assertInstance.Equal(word, sameWord)
+	}
+
+	_, _, err = randomizer.ChoiceList(nil)
+	assertInstance.Error(err)
+
+	mockInputer := &domainMocks.GameInputer{}
+	mockOutputer := &domainMocks.GameOutputer{}
+	mockStore := &applicationMocks.DailyStore{}
+	mockRecorder := &applicationMocks.GameRecorder{}
+
+	mockStore.On("LoadDailyResult", "2026-10-18", "hash").Return(nil, nil).Once()
+	mockStore.On("SaveDailyResult", mock.MatchedBy(func(result *domain.DailyResult) bool {
+		return !result.Finished
+	})).Return(nil).Once()
+	mockStore.On("SaveDailyResult", mock.MatchedBy(func(result *domain.DailyResult) bool {
+		return result.Finished && result.Won
+	})).Return(nil).Once()
+	mockInputer.On("GetGuess").Return(domain.NewWordGuess(word.Word), nil).Once()
+	mockOutputer.On("ShowGame", mock.Anything).Return()
+	mockOutputer.On("ShowGameResult", mock.Anything).Return().Once()
+	mockOutputer.On("ShowDailyResult", mock.Anything, false).Return().Once()
+	mockRecorder.On("RecordGame", mock.MatchedBy(func(savedGame *domain.SavedGame) bool {
+		return savedGame.Category == category.Name && savedGame.Difficulty == difficulty
+	})).Return(nil).Once()
+
+	assertInstance.NoError(application.RunDailyChallenge(settings, mockInputer, mockOutputer, mockStore, mockRecorder))
+
+	// The challenge is played once a day
+	played := &domain.DailyResult{Date: "2026-10-18", Collection: "hash", Finished: true, Won: true}
+	mockStore.On("LoadDailyResult", "2026-10-18", "hash").Return(played, nil).Once()
+	mockOutputer.On("ShowDailyResult", played, true).Return().Once()
+
+	assertInstance.NoError(application.RunDailyChallenge(settings, mockInputer, mockOutputer, mockStore, mockRecorder))
+
+	mockInputer.AssertExpectations(t)
+	mockOutputer.AssertExpectations(t)
+	mockStore.AssertExpectations(t)
+	mockRecorder.AssertExpectations(t)
+}
+
 func TestRunGameSession(t *testing.T) {
 	log.SetOutput(io.Discard)
 
 	mockWordRandomizer := &applicationMocks.WordRandomizer{}
 	mockInputer := &domainMocks.GameInputer{}
 	mockOutputer := &domainMocks.GameOutputer{}
+	mockSaver := &applicationMocks.GameSaver{}
 
 	mockWordRandomizer.On("ChoiceWord", mock.Anything, mock.Anything).Return(&domain.Word{Word: "test word"}, nil)
 
-	mockInputer.On("GetLetter").Return(rune('t'), nil).Once()
-	mockInputer.On("GetLetter").Return(rune('e'), nil).Once()
-	mockInputer.On("GetLetter").Return(rune('s'), nil).Once()
-	mockInputer.On("GetLetter").Return(rune('w'), nil).Once()
-	mockInputer.On("GetLetter").Return(rune('o'), nil).Once()
-	mockInputer.On("GetLetter").Return(rune('r'), nil).Once()
-	mockInputer.On("GetLetter").Return(rune('d'), nil).Once()
+	mockInputer.On("GetGuess").Return(domain.NewLetterGuess('t'), nil).Once()
+	mockInputer.On("GetGuess").Return(domain.NewLetterGuess('e'), nil).Once()
+	mockInputer.On("GetGuess").Return(domain.NewLetterGuess('s'), nil).Once()
+	mockInputer.On("GetGuess").Return(domain.NewLetterGuess('w'), nil).Once()
+	mockInputer.On("GetGuess").Return(domain.NewLetterGuess('o'), nil).Once()
+	mockInputer.On("GetGuess").Return(domain.NewLetterGuess('r'), nil).Once()
+	mockInputer.On("GetGuess").Return(domain.NewLetterGuess('d'), nil).Once()
+
+	mockSaver.On("SaveGame", mock.Anything).Return(nil)
+	mockSaver.On("RemoveSavedGame").Return(nil).Once()
+	mockSaver.On("RecordGame", mock.Anything).Return(nil).Once()
 
 	// Check number of updates - 1 initial + 7 letters + 1 final
 	mockOutputer.On("ShowGame", mock.Anything).Return().Times(1 + 7 + 1)
 	mockOutputer.On("ShowGameResult", mock.Anything).Return().Once()
 
-	err := application.RunGameSession(nil, domain.UnknownDifficulty, 6, mockInputer, mockOutputer, mockWordRandomizer)
+	err := application.RunGameSession(&application.GameSettings{
+		Category:         nil,
+		Difficulty:       domain.UnknownDifficulty,
+		MaxMistakes:      6,
+		WordGuessPenalty: domain.DefaultWordGuessPenalty,
+		HintPolicy:       domain.DefaultHintPolicy,
+		Random:           application.NewRandom(1),
+	}, mockInputer, mockOutputer, mockWordRandomizer, mockSaver)
 	// Return from infinite game-loop check
 	assert.Nil(t, err)
 }
+
+func TestRunGameSessionKeepsCategory(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+	category := &domain.Category{Name: "Animals", WordsByLevel: [][]domain.Word{{{Word: "Cat"}}}}
+
+	mockInputer := &domainMocks.GameInputer{}
+	mockOutputer := &domainMocks.GameOutputer{}
+	mockSaver := &applicationMocks.GameSaver{}
+
+	mockInputer.On("GetGuess").Return(domain.NewWordGuess("cat"), nil).Once()
+	mockSaver.On("SaveGame", mock.Anything).Return(nil)
+	mockSaver.On("RemoveSavedGame").Return(nil).Once()
+	mockSaver.On("RecordGame", mock.Anything).Return(nil).Once()
+	mockOutputer.On("ShowGame", mock.Anything).Return()
+	mockOutputer.On("ShowGameResult", mock.MatchedBy(func(game *domain.Game) bool { return game.IsWin() })).Return().Once()
+
+	err := application.RunGameSession(&application.GameSettings{
+		Category:         category,
+		Difficulty:       domain.EasyDifficulty,
+		MaxMistakes:      6,
+		WordGuessPenalty: domain.DefaultWordGuessPenalty,
+		HintPolicy:       domain.DefaultHintPolicy,
+		Random:           application.NewRandom(1),
+	}, mockInputer, mockOutputer, application.NewWeightedRandomizer(domain.TagFilter{}, application.NewRandom(1)), mockSaver)
+	assertInstance.NoError(err)
+	mockOutputer.AssertExpectations(t)
+
+	// The game folds its own copy of the word, the collection is left as it is loaded
+	assertInstance.Equal("Cat", category.WordsByLevel[domain.EasyDifficulty][0].Word)
+
+	// So the recent words are still found in the collection after they are played
+	category.WordsByLevel[domain.EasyDifficulty] = append(category.WordsByLevel[domain.EasyDifficulty], domain.Word{Word: "Dog"})
+	history := &domain.WordHistory{}
+	policy := domain.RepeatPolicy{Mode: domain.AvoidRecent, Recent: 1}
+	random := application.NewRandom(1)
+
+	first, err := history.Choose(category.WordsByLevel[domain.EasyDifficulty], "Animals/easy", policy, random)
+	assertInstance.NoError(err)
+	domain.NewGame(first, nil, 6, domain.DefaultWordGuessPenalty)
+
+	for range 10 {
+		next, err := history.Choose(category.WordsByLevel[domain.EasyDifficulty], "Animals/easy", policy, random)
+		assertInstance.NoError(err)
+		assertInstance.NotEqual(first.Word, next.Word)
+
+		domain.NewGame(next, nil, 6, domain.DefaultWordGuessPenalty)
+		first = next
+	}
+}
+
+func TestRunGameSessionOpponent(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+	words := []domain.Word{{Word: "cat"}, {Word: "dog"}}
+	category := &domain.Category{Name: "Animals", WordsByLevel: [][]domain.Word{{words[0]}}}
+
+	mockInputer := &domainMocks.GameInputer{}
+	mockOutputer := &domainMocks.GameOutputer{}
+	mockSaver := &applicationMocks.GameSaver{}
+
+	mockInputer.On("GetGuess").Return(domain.NewLetterGuess('z'), nil).Once()
+	mockInputer.On("GetGuess").Return(domain.NewWordGuess("cat"), nil).Once()
+	mockSaver.On("SaveGame", mock.Anything).Return(nil)
+	mockSaver.On("RemoveSavedGame").Return(nil).Once()
+	mockSaver.On("RecordGame", mock.Anything).Return(nil).Once()
+	mockOutputer.On("ShowGame", mock.Anything).Return()
+	mockOutputer.On("ShowGameResult", mock.Anything).Return().Once()
+
+	// The solver plays the same word within the same limits after the player
+	mockOutputer.On("ShowOpponentResult", mock.MatchedBy(func(game *domain.Game) bool {
+		return game.IsWin() && game.Pattern() == "cat" && game.MaxMistakes() == 3 && game.Mistakes() == 0
+	}), mock.MatchedBy(func(guesses []string) bool { return len(guesses) > 0 })).Return().Once()
+
+	err := application.RunGameSession(&application.GameSettings{
+		Category:         category,
+		Difficulty:       domain.EasyDifficulty,
+		MaxMistakes:      3,
+		WordGuessPenalty: domain.DefaultWordGuessPenalty,
+		HintPolicy:       domain.DefaultHintPolicy,
+		Practice:         true,
+		Opponent:         words,
+		Random:           application.NewRandom(1),
+	}, mockInputer, mockOutputer, application.NewWeightedRandomizer(domain.TagFilter{}, application.NewRandom(1)), mockSaver)
+	assertInstance.NoError(err)
+	mockOutputer.AssertExpectations(t)
+}
+
+func TestRunGameSessionWordGuess(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	mockWordRandomizer := &applicationMocks.WordRandomizer{}
+	mockInputer := &domainMocks.GameInputer{}
+	mockOutputer := &domainMocks.GameOutputer{}
+	mockSaver := &applicationMocks.GameSaver{}
+
+	mockWordRandomizer.On("ChoiceWord", mock.Anything, mock.Anything).Return(&domain.Word{Word: "test word"}, nil)
+
+	mockInputer.On("GetGuess").Return(domain.NewWordGuess("best word"), nil).Once()
+	mockInputer.On("GetGuess").Return(domain.NewLetterGuess('t'), nil).Once()
+	mockInputer.On("GetGuess").Return(domain.NewWordGuess("test word"), nil).Once()
+
+	mockSaver.On("SaveGame", mock.Anything).Return(nil)
+	mockSaver.On("RemoveSavedGame").Return(nil).Once()
+	mockSaver.On("RecordGame", mock.Anything).Return(nil).Once()
+
+	// Check number of updates - 1 before each of 3 guesses + 1 final
+	mockOutputer.On("ShowGame", mock.Anything).Return().Times(3 + 1)
+	mockOutputer.On("ShowGameResult", mock.MatchedBy(func(game *domain.Game) bool {
+		return game.IsWin() && game.Mistakes() == domain.DefaultWordGuessPenalty
+	})).Return().Once()
+
+	err := application.RunGameSession(&application.GameSettings{
+		Category:         &domain.Category{Name: "Category"},
+		Difficulty:       domain.EasyDifficulty,
+		MaxMistakes:      6,
+		WordGuessPenalty: domain.DefaultWordGuessPenalty,
+		HintPolicy:       domain.DefaultHintPolicy,
+		Random:           application.NewRandom(1),
+	}, mockInputer, mockOutputer, mockWordRandomizer, mockSaver)
+	assert.Nil(t, err)
+	mockOutputer.AssertExpectations(t)
+}
+
+// fakeClock moves on to the next timer only while a guess waits for the timeout, so timed games run without waiting
+// and guesses made at once take no time. Instant clocks move on by the waited duration at once.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	instant bool
+	timers  []fakeTimer
+}
+
+type fakeTimer struct {
+	at    time.Time
+	fired chan time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fired := make(chan time.Time, 1)
+
+	if c.instant {
+		c.now = c.now.Add(d)
+		fired <- c.now
+
+		return fired
+	}
+
+	c.timers = append(c.timers, fakeTimer{at: c.now.Add(d), fired: fired})
+
+	return fired
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// waitForTimeout fires the timers in order until the guess is cancelled, so the time runs out at the deadline.
+func (c *fakeClock) waitForTimeout(ctx context.Context) (*domain.Guess, error) {
+	for ctx.Err() == nil {
+		c.mu.Lock()
+
+		if len(c.timers) > 0 {
+			next := slices.MinFunc(c.timers, func(a, b fakeTimer) int { return a.at.Compare(b.at) })
+			c.timers = slices.DeleteFunc(c.timers, func(timer fakeTimer) bool { return timer.fired == next.fired })
+
+			if next.at.After(c.now) {
+				c.now = next.at
+			}
+
+			next.fired <- c.now
+		}
+
+		c.mu.Unlock()
+
+		time.Sleep(time.Millisecond)
+	}
+
+	return nil, context.Cause(ctx)
+}
+
+func TestRunTimedGameSession(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	tests := []struct {
+		name     string
+		limits   domain.TimeLimits
+		guesses  []*domain.Guess
+		win      bool
+		mistakes int
+	}{
+		{
+			name:     "Guess timeout is a mistake",
+			limits:   domain.TimeLimits{Guess: 10 * time.Second},
+			guesses:  []*domain.Guess{nil, domain.NewLetterGuess('a'), domain.NewLetterGuess('b')},
+			win:      true,
+			mistakes: 1,
+		},
+		{
+			name:     "Game timeout loses the game",
+			limits:   domain.TimeLimits{Guess: 10 * time.Second, Game: 25 * time.Second},
+			guesses:  []*domain.Guess{domain.NewLetterGuess('a'), nil, nil, nil},
+			win:      false,
+			mistakes: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockWordRandomizer := &applicationMocks.WordRandomizer{}
+			mockInputer := &domainMocks.ContextGameInputer{}
+			mockOutputer := &domainMocks.GameOutputer{}
+			mockSaver := &applicationMocks.GameSaver{}
+			clock := &fakeClock{now: time.Unix(0, 0)}
+
+			mockWordRandomizer.On("ChoiceWord", mock.Anything, mock.Anything).Return(&domain.Word{Word: "ab"}, nil)
+
+			// nil guesses wait until the time is over
+			for _, guess := range test.guesses {
+				if guess == nil {
+					mockInputer.EXPECT().GetGuessContext(mock.Anything).RunAndReturn(clock.waitForTimeout).Once()
+				} else {
+					mockInputer.EXPECT().GetGuessContext(mock.Anything).Return(guess, nil).Once()
+				}
+			}
+
+			mockSaver.On("RecordGame", mock.Anything).Return(nil).Once()
+
+			mockOutputer.On("ShowGame", mock.Anything).Return()
+			mockOutputer.On("ShowTimeLeft", mock.Anything, mock.Anything).Return()
+			mockOutputer.On("ShowGameResult", mock.MatchedBy(func(game *domain.Game) bool {
+				return game.IsWin() == test.win && game.Mistakes() == test.mistakes
+			})).Return().Once()
+
+			timing := &application.Timing{Limits: test.limits, Clock: clock}
+
+			err := application.RunGameSession(&application.GameSettings{
+				Category:         &domain.Category{Name: "Category"},
+				Difficulty:       domain.EasyDifficulty,
+				MaxMistakes:      6,
+				WordGuessPenalty: domain.DefaultWordGuessPenalty,
+				HintPolicy:       domain.DefaultHintPolicy,
+				Random:           application.NewRandom(1),
+				Timing:           timing,
+			}, mockInputer, mockOutputer, mockWordRandomizer, mockSaver)
+
+			assert.NoError(t, err)
+			mockInputer.AssertExpectations(t)
+			mockOutputer.AssertExpectations(t)
+			// Timed games are not saved, only recorded when finished
+			mockSaver.AssertNotCalled(t, "SaveGame", mock.Anything)
+			mockSaver.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRunTimedGameSessionWithoutContext(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	mockWordRandomizer := &applicationMocks.WordRandomizer{}
+	mockInputer := &domainMocks.GameInputer{}
+	mockOutputer := &domainMocks.GameOutputer{}
+	mockSaver := &applicationMocks.GameSaver{}
+	clock := &fakeClock{now: time.Unix(0, 0)}
+
+	mockWordRandomizer.On("ChoiceWord", mock.Anything, mock.Anything).Return(&domain.Word{Word: "ab"}, nil)
+
+	// The inputer can not be interrupted, so the first guess is given after the time is over and does not count
+	mockInputer.EXPECT().GetGuess().RunAndReturn(func() (*domain.Guess, error) {
+		clock.advance(15 * time.Second)
+		return domain.NewLetterGuess('a'), nil
+	}).Once()
+	mockInputer.EXPECT().GetGuess().Return(domain.NewLetterGuess('a'), nil).Once()
+	mockInputer.EXPECT().GetGuess().Return(domain.NewLetterGuess('b'), nil).Once()
+
+	mockSaver.On("RecordGame", mock.Anything).Return(nil).Once()
+
+	mockOutputer.On("ShowGame", mock.Anything).Return()
+	mockOutputer.On("ShowTimeLeft", mock.Anything, mock.Anything).Return()
+	mockOutputer.On("ShowGameResult", mock.MatchedBy(func(game *domain.Game) bool {
+		return game.IsWin() && game.Mistakes() == 1
+	})).Return().Once()
+
+	timing := &application.Timing{Limits: domain.TimeLimits{Guess: 10 * time.Second}, Clock: clock}
+
+	err := application.RunGameSession(&application.GameSettings{
+		Category:         &domain.Category{Name: "Category"},
+		Difficulty:       domain.EasyDifficulty,
+		MaxMistakes:      6,
+		WordGuessPenalty: domain.DefaultWordGuessPenalty,
+		HintPolicy:       domain.DefaultHintPolicy,
+		Random:           application.NewRandom(1),
+		Timing:           timing,
+	}, mockInputer, mockOutputer, mockWordRandomizer, mockSaver)
+
+	assert.NoError(t, err)
+	mockInputer.AssertExpectations(t)
+	mockOutputer.AssertExpectations(t)
+}
+
+func TestRunReplay(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	mockOutputer := &domainMocks.GameOutputer{}
+
+	game := domain.NewGame(&domain.Word{Word: "ab"}, nil, 6, domain.DefaultWordGuessPenalty)
+	game.MakeGuess(domain.NewLetterGuess('a'))
+	game.MakeGuess(domain.NewLetterGuess('x'))
+	game.MakeGuess(domain.NewLetterGuess('b'))
+
+	// Every event is a step: started, 3 letters and won
+	mockOutputer.On("ShowReplayStep", mock.Anything, mock.Anything).Return().Times(5)
+	mockOutputer.On("ShowGameResult", mock.MatchedBy(func(game *domain.Game) bool {
+		return game.IsWin() && game.Mistakes() == 1
+	})).Return().Once()
+
+	clock := &fakeClock{now: time.Unix(0, 0), instant: true}
+
+	err := application.RunReplay(&domain.SavedGame{Game: game}, mockOutputer, clock)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Unix(0, 0).Add(5*application.ReplayStepDelay), clock.Now())
+	mockOutputer.AssertExpectations(t)
+
+	err = application.RunReplay(&domain.SavedGame{Game: domain.NewGame(&domain.Word{Word: "ab"}, nil, 6, 2)}, mockOutputer, clock)
+	assert.Error(t, err)
+}
+
+func TestResumeGameSession(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	mockInputer := &domainMocks.GameInputer{}
+	mockOutputer := &domainMocks.GameOutputer{}
+	mockSaver := &applicationMocks.GameSaver{}
+
+	savedGameJSON := &domain.SavedGameJSON{
+		Version:    domain.SavedGameVersion,
+		Category:   "Category",
+		Difficulty: "easy",
+		GameJSON: domain.GameJSON{
+			MaxMistakes:      6,
+			WordGuessPenalty: domain.DefaultWordGuessPenalty,
+			Word:             domain.WordJSON{Word: "test", Hint: "hint"},
+			Attempts:         3,
+			Mistakes:         1,
+			Used:             []string{"t", "e", "x"},
+		},
+	}
+
+	savedGame, err := savedGameJSON.ToDomain()
+	assert.NoError(t, err)
+
+	mockInputer.On("GetGuess").Return(domain.NewLetterGuess('s'), nil).Once()
+
+	// Game must be saved after resuming and after the guess, then removed when finished
+	mockSaver.On("SaveGame", savedGame).Return(nil).Twice()
+	mockSaver.On("RemoveSavedGame").Return(nil).Once()
+	mockSaver.On("RecordGame", mock.Anything).Return(nil).Once()
+
+	mockOutputer.On("ShowGame", mock.Anything).Return().Twice()
+	mockOutputer.On("ShowGameResult", mock.MatchedBy(func(game *domain.Game) bool {
+		return game.IsWin() && game.Attempts() == 4 && game.Mistakes() == 1
+	})).Return().Once()
+
+	err = application.ResumeGameSession(savedGame, application.NewRandom(1), mockInputer, mockOutputer, mockSaver)
+	assert.NoError(t, err)
+	mockSaver.AssertExpectations(t)
+	mockOutputer.AssertExpectations(t)
+}
+
+func TestRunMatch(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	mockWordRandomizer := &applicationMocks.WordRandomizer{}
+	mockInputer := &domainMocks.GameInputer{}
+	mockOutputer := &domainMocks.GameOutputer{}
+
+	categories := []domain.Category{{Name: "Category1"}, {Name: "Category2"}}
+
+	mockWordRandomizer.On("ChoiceWord", mock.Anything, domain.MediumDifficulty).Return(&domain.Word{Word: "ab"}, nil).Once()
+	mockWordRandomizer.On("ChoiceWord", mock.Anything, domain.MediumDifficulty).Return(&domain.Word{Word: "cd"}, nil).Once()
+
+	// First round is won without mistakes, second one is lost
+	mockInputer.On("GetGuess").Return(domain.NewWordGuess("ab"), nil).Once()
+	mockInputer.On("GetGuess").Return(domain.NewWordGuess("xy"), nil).Once()
+	mockInputer.On("WaitContinue").Return(nil).Once()
+
+	mockOutputer.On("ShowGame", mock.Anything).Return()
+	mockOutputer.On("ShowGameResult", mock.Anything).Return().Twice()
+	mockOutputer.On("ShowRoundSummary", mock.Anything).Return().Twice()
+
+	match, err := application.RunMatch(&application.MatchSettings{
+		Rounds:           2,
+		Categories:       categories,
+		Category:         nil,
+		Difficulty:       domain.MediumDifficulty,
+		MaxMistakes:      2,
+		WordGuessPenalty: domain.DefaultWordGuessPenalty,
+		Random:           application.NewRandom(1),
+	}, mockInputer, mockOutputer, mockWordRandomizer)
+
+	assert.NoError(t, err)
+	assert.True(t, match.IsFinished())
+	assert.Equal(t, 1, match.Wins())
+	assert.Equal(t, (2*domain.LetterScore+domain.RemainingMistakesMax)*2, match.TotalScore())
+	assert.Contains(t, []string{"Category1", "Category2"}, match.Results()[0].Category)
+	mockInputer.AssertExpectations(t)
+	mockOutputer.AssertExpectations(t)
+}
+
+func TestRunAdaptiveMatch(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	mockWordRandomizer := &applicationMocks.WordRandomizer{}
+	mockInputer := &domainMocks.GameInputer{}
+	mockOutputer := &domainMocks.GameOutputer{}
+
+	// The level goes up after a window of rounds won without mistakes
+	mockWordRandomizer.On("ChoiceWord", mock.Anything, domain.MediumDifficulty).
+		Return(&domain.Word{Word: "ab"}, nil).Times(domain.AdaptiveWindow)
+	mockWordRandomizer.On("ChoiceWord", mock.Anything, domain.HardDifficulty).Return(&domain.Word{Word: "ab"}, nil).Once()
+
+	mockInputer.On("GetGuess").Return(domain.NewWordGuess("ab"), nil)
+	mockInputer.On("WaitContinue").Return(nil)
+
+	mockOutputer.On("ShowGame", mock.Anything).Return()
+	mockOutputer.On("ShowGameResult", mock.Anything).Return()
+	mockOutputer.On("ShowRoundSummary", mock.Anything).Return()
+
+	match, err := application.RunMatch(&application.MatchSettings{
+		Rounds:           domain.AdaptiveWindow + 1,
+		Category:         &domain.Category{Name: "Category"},
+		Difficulty:       domain.AdaptiveDifficulty,
+		MaxMistakes:      2,
+		WordGuessPenalty: domain.DefaultWordGuessPenalty,
+		Random:           application.NewRandom(1),
+	}, mockInputer, mockOutputer, mockWordRandomizer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.HardDifficulty, match.Results()[domain.AdaptiveWindow].Difficulty)
+	assert.Equal(t, domain.HardDifficulty, match.AdaptiveLevel().Difficulty())
+	mockWordRandomizer.AssertExpectations(t)
+}
+
+func TestRunHotSeatSession(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	mockWordRandomizer := &applicationMocks.WordRandomizer{}
+	mockInputer := &domainMocks.GameInputer{}
+	mockOutputer := &domainMocks.GameOutputer{}
+	mockRecorder := &applicationMocks.ResultRecorder{}
+
+	mockWordRandomizer.On("ChoiceWord", mock.Anything, mock.Anything).Return(&domain.Word{Word: "ab"}, nil)
+
+	// Every player gets the result, only the one who revealed the last letter wins
+	mockRecorder.On("RecordResult", "Alice", mock.MatchedBy(func(result *domain.GameResult) bool {
+		return !result.Won && result.Attempts == 1 && result.Category == "Category" && result.Difficulty == "easy"
+	})).Return(nil).Once()
+	mockRecorder.On("RecordResult", "Bob", mock.MatchedBy(func(result *domain.GameResult) bool {
+		return result.Won && result.Attempts == 1 && result.Word == "ab"
+	})).Return(assert.AnError).Once()
+
+	mockInputer.On("GetGuess").Return(domain.NewLetterGuess('a'), nil).Once()
+	mockInputer.On("GetGuess").Return(nil, &domain.InputerError{Message: "letter validation"}).Once()
+	mockInputer.On("GetGuess").Return(domain.NewWordGuess("ab"), nil).Once()
+
+	mockOutputer.On("ShowHotSeatGame", mock.Anything).Return()
+	mockOutputer.On("ShowInputError", mock.Anything).Return().Once()
+	mockOutputer.On("ShowHotSeatResult", mock.MatchedBy(func(hotSeat *domain.HotSeatGame) bool {
+		return hotSeat.Winner() != nil && hotSeat.Winner().Name() == "Bob"
+	})).Return().Once()
+
+	err := application.RunHotSeatSession(&application.HotSeatSettings{
+		Category:         &domain.Category{Name: "Category"},
+		Difficulty:       domain.EasyDifficulty,
+		MaxMistakes:      6,
+		WordGuessPenalty: domain.DefaultWordGuessPenalty,
+		Players:          []string{"Alice", "Bob"},
+		MistakesRule:     domain.SharedMistakes,
+		HintPolicy:       domain.DefaultHintPolicy,
+	}, mockInputer, mockOutputer, mockWordRandomizer, mockRecorder)
+
+	// A result that is not recorded does not fail the played game
+	assert.NoError(t, err)
+	mockOutputer.AssertExpectations(t)
+	mockRecorder.AssertExpectations(t)
+}
+
+func TestRunEvilGameSession(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	mockWordRandomizer := &applicationMocks.WordRandomizer{}
+	mockInputer := &domainMocks.GameInputer{}
+	mockOutputer := &domainMocks.GameOutputer{}
+	mockRecorder := &applicationMocks.ResultRecorder{}
+
+	category := &domain.Category{
+		Name:         "Animals",
+		WordsByLevel: [][]domain.Word{{{Word: "cat"}, {Word: "dog"}}},
+	}
+
+	mockWordRandomizer.On("ChoiceWord", category, domain.EasyDifficulty).Return(&category.WordsByLevel[domain.EasyDifficulty][0], nil)
+
+	mockInputer.On("GetGuess").Return(domain.NewWordGuess("cat"), nil).Once()
+	mockInputer.On("GetGuess").Return(domain.NewWordGuess("dog"), nil).Once()
+
+	mockOutputer.On("ShowGame", mock.Anything).Return()
+	mockRecorder.On("RecordResult", "", mock.MatchedBy(func(result *domain.GameResult) bool {
+		return result.Won && result.Word == "dog" && result.Mistakes == domain.DefaultWordGuessPenalty && result.Category == "Animals"
+	})).Return(nil).Once()
+	mockOutputer.On("ShowGameResult", mock.MatchedBy(func(game *domain.EvilGame) bool {
+		return game.IsWin() && game.Mistakes() == domain.DefaultWordGuessPenalty
+	})).Return().Once()
+
+	err := application.RunEvilGameSession(&application.GameSettings{
+		Category:         category,
+		Difficulty:       domain.EasyDifficulty,
+		MaxMistakes:      6,
+		WordGuessPenalty: domain.DefaultWordGuessPenalty,
+		HintPolicy:       domain.DefaultHintPolicy,
+	}, mockInputer, mockOutputer, mockWordRandomizer, mockRecorder)
+
+	assert.NoError(t, err)
+	mockOutputer.AssertExpectations(t)
+	mockRecorder.AssertExpectations(t)
+
+	// Candidates without the tags are not played
+	category = &domain.Category{
+		Name: "Animals",
+		WordsByLevel: [][]domain.Word{{
+			{Word: "cat", Tags: []string{"pet"}}, {Word: "dog"}, {Word: "cow", Tags: []string{"pet", "farm"}},
+		}},
+	}
+	tags := domain.TagFilter{Required: []string{"pet"}, Excluded: []string{"farm"}}
+
+	mockWordRandomizer.On("ChoiceWord", category, domain.EasyDifficulty).Return(&category.WordsByLevel[domain.EasyDifficulty][0], nil)
+	mockInputer.On("GetGuess").Return(domain.NewWordGuess("cat"), nil).Once()
+	mockOutputer.On("ShowGameResult", mock.MatchedBy(func(game *domain.EvilGame) bool {
+		return game.IsWin() && game.Mistakes() == 0
+	})).Return().Once()
+	mockRecorder.On("RecordResult", "", mock.MatchedBy(func(result *domain.GameResult) bool {
+		return result.Won && result.Word == "cat"
+	})).Return(nil).Once()
+
+	err = application.RunEvilGameSession(&application.GameSettings{
+		Category:         category,
+		Difficulty:       domain.EasyDifficulty,
+		MaxMistakes:      6,
+		WordGuessPenalty: domain.DefaultWordGuessPenalty,
+		HintPolicy:       domain.DefaultHintPolicy,
+		Tags:             tags,
+	}, mockInputer, mockOutputer, mockWordRandomizer, mockRecorder)
+
+	assert.NoError(t, err)
+	mockOutputer.AssertExpectations(t)
+}
+
+func TestSolver(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	words := []domain.Word{{Word: "cat"}, {Word: "car"}, {Word: "cow"}, {Word: "horse"}}
+	game := domain.NewGame(&domain.Word{Word: "car"}, domain.LatinAlphabet, 6, domain.DefaultWordGuessPenalty)
+	solver := application.NewSolver(words, game)
+
+	// Only words of the same length are candidates
+	assertInstance.Equal([]string{"cat", "car", "cow"}, solver.Candidates())
+
+	// "c" is in every candidate
+	guess, err := solver.GetGuess()
+	assertInstance.NoError(err)
+	assertInstance.Equal(domain.NewLetterGuess('c'), guess)
+	game.MakeGuess(guess)
+
+	// "a" is in two of three candidates
+	guess, err = solver.GetGuess()
+	assertInstance.NoError(err)
+	assertInstance.Equal(domain.NewLetterGuess('a'), guess)
+	game.MakeGuess(guess)
+
+	assertInstance.Equal([]string{"cat", "car"}, solver.Candidates())
+
+	// "r" goes before "t" alphabetically
+	guess, err = solver.GetGuess()
+	assertInstance.NoError(err)
+	assertInstance.Equal(domain.NewLetterGuess('r'), guess)
+	game.MakeGuess(guess)
+
+	assertInstance.True(game.IsWin())
+	assertInstance.Equal([]string{"c", "a", "r"}, solver.Sequence())
+}
+
+func TestSolverExcludesMissedLetters(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	words := []domain.Word{{Word: "cat"}, {Word: "dog"}, {Word: "pig"}}
+	game := domain.NewGame(&domain.Word{Word: "pig"}, domain.LatinAlphabet, 6, domain.DefaultWordGuessPenalty)
+	solver := application.NewSolver(words, game)
+
+	game.Guess('o')
+	assertInstance.Equal([]string{"cat", "pig"}, solver.Candidates())
+
+	game.Guess('a')
+	assertInstance.Equal([]string{"pig"}, solver.Candidates())
+
+	// Single candidate is guessed as a whole word
+	guess, err := solver.GetGuess()
+	assertInstance.NoError(err)
+	assertInstance.Equal(domain.NewWordGuess("pig"), guess)
+}
+
+func TestSolveCollection(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	categories := []domain.Category{
+		{
+			Name:         "Animals",
+			WordsByLevel: [][]domain.Word{{{Word: "Cat"}, {Word: "dog"}}, {{Word: "horse"}}},
+		},
+		{
+			Name:         "Colors",
+			WordsByLevel: [][]domain.Word{nil, nil, {{Word: "red"}}},
+		},
+	}
+
+	results := application.SolveCollection(categories, domain.DefaultDifficultyLevels, 6, domain.DefaultWordGuessPenalty)
+
+	assertInstance.Len(results, 4)
+
+	for _, result := range results {
+		assertInstance.True(result.Win, result.Word)
+		assertInstance.LessOrEqual(result.Mistakes, 6, result.Word)
+		assertInstance.Equal(6, result.MaxMistakes, result.Word)
+		assertInstance.NotEmpty(result.Guesses, result.Word)
+	}
+
+	assertInstance.Equal("cat", results[0].Word)
+	assertInstance.Equal(domain.MediumDifficulty, results[2].Difficulty)
+	assertInstance.Equal("Colors", results[3].Category)
+
+	// Words are solved within the maxMistakes of their levels when it is not set for every word
+	levels := domain.DifficultyLevels{{Name: "easy", MaxMistakes: 8}, {Name: "medium"}, {Name: "hard", MaxMistakes: 1}}
+	results = application.SolveCollection(categories, levels, 0, domain.DefaultWordGuessPenalty)
+
+	assertInstance.Equal(8, results[0].MaxMistakes)
+	assertInstance.Equal(domain.StateCount, results[2].MaxMistakes)
+	assertInstance.Equal(1, results[3].MaxMistakes)
+	assertInstance.LessOrEqual(results[3].Mistakes, 1)
+}
+
+func TestSimulateCollection(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	// "elephant" is the only word of its length, so the solver names it at once
+	categories := []domain.Category{
+		{
+			Name: "Animals",
+			WordsByLevel: [][]domain.Word{
+				{{Word: "bat"}, {Word: "cat"}, {Word: "hat"}, {Word: "mat"}, {Word: "rat"}},
+				nil,
+				{{Word: "elephant"}},
+			},
+		},
+	}
+	settings := &application.SimulationSettings{Runs: 50, MaxMistakes: 6, WordGuessPenalty: domain.DefaultWordGuessPenalty}
+
+	report := application.SimulateCollection(categories, settings, rand.New(rand.NewPCG(1, 2)))
+
+	assertInstance.Len(report.Words, 6)
+	assertInstance.Len(report.Categories, 1)
+
+	elephant := report.Words[5]
+	assertInstance.Equal("elephant", elephant.Word)
+	assertInstance.Equal(domain.HardDifficulty, elephant.Declared)
+	assertInstance.Equal(domain.EasyDifficulty, elephant.Measured)
+	assertInstance.Equal(1.0, elephant.WinRate)
+	assertInstance.Equal(0.0, elephant.AvgMistakes)
+	assertInstance.Equal(1.0, elephant.AvgAttempts)
+
+	// Exactly one of the rhyming words takes the hard bucket
+	mismatches := report.Mismatches()
+	assertInstance.Len(mismatches, 2)
+	assertInstance.Equal(2, report.Categories[0].Mismatches)
+	assertInstance.Equal(300, report.Categories[0].Runs)
+
+	for _, word := range report.Words[:5] {
+		assertInstance.Equal(50, word.Runs)
+		assertInstance.Equal(6, word.MaxMistakes)
+		assertInstance.Greater(word.AvgMistakes, 0.0, word.Word)
+	}
+
+	// The same seed gives the same report
+	assertInstance.Equal(report, application.SimulateCollection(categories, settings, rand.New(rand.NewPCG(1, 2))))
+
+	// Words are played within the maxMistakes of their levels when it is not set for every word
+	settings.MaxMistakes = 0
+	settings.Levels = domain.DifficultyLevels{{Name: "easy", MaxMistakes: 3}, {Name: "medium"}, {Name: "hard", MaxMistakes: 8}}
+	report = application.SimulateCollection(categories, settings, rand.New(rand.NewPCG(1, 2)))
+
+	assertInstance.Equal(3, report.Words[0].MaxMistakes)
+	assertInstance.LessOrEqual(report.Words[0].AvgMistakes, 3.0)
+	assertInstance.Equal(8, report.Words[5].MaxMistakes)
+}
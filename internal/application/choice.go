@@ -1,9 +1,9 @@
 package application
 
 import (
-	"crypto/rand"
 	"fmt"
-	"math/big"
+	"log/slog"
+	"math/rand/v2"
 
 	"makly/hangman/internal/domain"
 )
@@ -12,50 +12,119 @@ type WordRandomizer interface {
 	ChoiceWord(category *domain.Category, difficulty domain.Difficulty) (word *domain.Word, err error)
 }
 
-type RandomDefault struct{}
+type WordHistoryStore interface {
+	LoadWordHistory(collection string) (history *domain.WordHistory, err error)
+	SaveWordHistory(collection string, history *domain.WordHistory) (err error)
+}
 
-func ChoiceDifficulty() (difficulty domain.Difficulty, err error) {
-	n, err := rand.Int(rand.Reader, big.NewInt(int64(domain.DifficultyCount)))
-	if err != nil {
-		return -1, fmt.Errorf("random choose word: %w", err)
+// NewRandom returns the source of every random choice of a session, sessions with the same seed make the same choices.
+func NewRandom(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+func ChoiceDifficulty(levels domain.DifficultyLevels, random *rand.Rand) domain.Difficulty {
+	return domain.Difficulty(random.IntN(levels.Count()))
+}
+
+func ChoiceCategory(categories []domain.Category, random *rand.Rand) (category *domain.Category, err error) {
+	if len(categories) == 0 {
+		return nil, &domain.BadWordsCollectionError{Message: "there are no categories to choose from"}
 	}
 
-	return domain.Difficulty(n.Int64()), nil
+	return &categories[random.IntN(len(categories))], nil
+}
+
+// WeightedRandomizer chooses the words that pass the tag filter with a probability proportional to their weight.
+type WeightedRandomizer struct {
+	filter domain.TagFilter
+	random *rand.Rand
 }
 
-func ChoiceCategory(categories []domain.Category) (category *domain.Category, err error) {
-	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(categories))))
+func NewWeightedRandomizer(filter domain.TagFilter, random *rand.Rand) *WeightedRandomizer {
+	return &WeightedRandomizer{filter: filter, random: random}
+}
+
+func (w *WeightedRandomizer) ChoiceWord(category *domain.Category, difficulty domain.Difficulty) (word *domain.Word, err error) {
+	words, err := wordsToChoose(category, difficulty, w.filter)
 	if err != nil {
-		return nil, fmt.Errorf("random choose category: %w", err)
+		return nil, err
 	}
 
-	return &categories[n.Int64()], nil
+	return domain.ChooseWeighted(words, w.random), nil
 }
 
-func (rd *RandomDefault) ChoiceWord(category *domain.Category, difficulty domain.Difficulty) (word *domain.Word, err error) {
-	var words []domain.Word
+// HistoryRandomizer chooses words by the repeat policy and keeps the played words in the history of the collection.
+type HistoryRandomizer struct {
+	store      WordHistoryStore
+	collection string
+	// levels name the lists of words in the history.
+	levels domain.DifficultyLevels
+	policy domain.RepeatPolicy
+	filter domain.TagFilter
+	random *rand.Rand
+}
 
-	switch difficulty {
-	case domain.EasyDifficulty:
-		words = category.EasyWords
-	case domain.MediumDifficulty:
-		words = category.MediumWords
-	case domain.HardDifficulty:
-		words = category.HardWords
-	case domain.UnknownDifficulty:
-		return nil, &domain.BadCategoryError{Message: "unknown difficulty"}
-	default:
+func NewHistoryRandomizer(
+	store WordHistoryStore,
+	collection string,
+	levels domain.DifficultyLevels,
+	policy domain.RepeatPolicy,
+	filter domain.TagFilter,
+	random *rand.Rand,
+) *HistoryRandomizer {
+	return &HistoryRandomizer{
+		store:      store,
+		collection: collection,
+		levels:     levels,
+		policy:     policy,
+		filter:     filter,
+		random:     random,
+	}
+}
+
+func (h *HistoryRandomizer) ChoiceWord(category *domain.Category, difficulty domain.Difficulty) (word *domain.Word, err error) {
+	words, err := wordsToChoose(category, difficulty, h.filter)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := h.store.LoadWordHistory(h.collection)
+	if err != nil {
+		slog.Warn("Word history is not loaded, words may repeat", slog.Any("error", err))
+
+		history = &domain.WordHistory{}
+	}
+
+	word, err = history.Choose(words, domain.WordListKey(category.Name, h.levels.Name(difficulty)), h.policy, h.random)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.store.SaveWordHistory(h.collection, history); err != nil {
+		slog.Warn("Word history is not saved", slog.Any("error", err))
+	}
+
+	slog.Info("Word chosen", slog.String("policy", h.policy.String()), slog.Any("word", word))
+
+	return word, nil
+}
+
+func wordsToChoose(category *domain.Category, difficulty domain.Difficulty, filter domain.TagFilter) (words []domain.Word, err error) {
+	if difficulty == domain.UnknownDifficulty {
 		return nil, &domain.BadCategoryError{Message: "unknown difficulty"}
 	}
 
+	words = category.WordsByDifficulty(difficulty)
 	if len(words) == 0 {
 		return nil, &domain.BadCategoryError{Message: "words list for chosen category and difficulty is empty"}
 	}
 
-	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(words))))
-	if err != nil {
-		return nil, fmt.Errorf("random choose word: %w", err)
+	words = filter.Filter(words)
+	if len(words) == 0 {
+		return nil, &domain.BadCategoryError{
+			Message: fmt.Sprintf("words list for chosen category and difficulty has no words with tags %q", filter.String()),
+		}
 	}
 
-	return &words[n.Int64()], nil
+	return words, nil
 }
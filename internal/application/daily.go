@@ -0,0 +1,140 @@
+package application
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"makly/hangman/internal/domain"
+)
+
+type DailyStore interface {
+	// LoadDailyResult returns nil when the challenge is not played yet.
+	LoadDailyResult(date, collection string) (result *domain.DailyResult, err error)
+	SaveDailyResult(result *domain.DailyResult) (err error)
+}
+
+type DailySettings struct {
+	// Date is the local date of the challenge in the time.DateOnly layout.
+	Date string
+	// CollectionHash is the content hash of the collection, so changed collections get another word.
+	CollectionHash string
+	Categories     []domain.Category
+	Levels         domain.DifficultyLevels
+	// MaxMistakes is zero when the maxMistakes of the level of the word is used.
+	MaxMistakes      int
+	WordGuessPenalty int
+	HintPolicies     *domain.HintPolicies
+}
+
+// DailyRandomizer derives every choice from the date and the collection, so everyone gets the same word of the day
+// without a server.
+type DailyRandomizer struct {
+	date       string
+	collection string
+	levels     domain.DifficultyLevels
+}
+
+func NewDailyRandomizer(date, collectionHash string, levels domain.DifficultyLevels) *DailyRandomizer {
+	return &DailyRandomizer{date: date, collection: collectionHash, levels: levels}
+}
+
+// ChoiceList chooses the category and the difficulty of the word among the lists that have words.
+func (d *DailyRandomizer) ChoiceList(categories []domain.Category) (category *domain.Category, difficulty domain.Difficulty, err error) {
+	type list struct {
+		category   *domain.Category
+		difficulty domain.Difficulty
+	}
+
+	lists := make([]list, 0, len(categories)*d.levels.Count())
+
+	for i := range categories {
+		for level, words := range categories[i].WordsByLevel {
+			if len(words) > 0 {
+				lists = append(lists, list{category: &categories[i], difficulty: domain.Difficulty(level)})
+			}
+		}
+	}
+
+	if len(lists) == 0 {
+		return nil, domain.UnknownDifficulty, &domain.BadWordsCollectionError{Message: "words collection is empty"}
+	}
+
+	chosen := lists[d.index(len(lists), "list")]
+
+	return chosen.category, chosen.difficulty, nil
+}
+
+func (d *DailyRandomizer) ChoiceWord(category *domain.Category, difficulty domain.Difficulty) (word *domain.Word, err error) {
+	// Tags and weights are not used, so everyone gets the same word whatever filter they play with
+	words, err := wordsToChoose(category, difficulty, domain.TagFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &words[d.index(len(words), "word", category.Name, d.levels.Title(difficulty))], nil
+}
+
+func (d *DailyRandomizer) index(n int, parts ...string) int {
+	seed := sha256.Sum256([]byte(strings.Join(append([]string{d.date, d.collection}, parts...), "\n")))
+
+	return int(binary.BigEndian.Uint64(seed[:8]) % uint64(n))
+}
+
+func RunDailyChallenge(
+	settings *DailySettings,
+	inputer domain.GameInputer,
+	outputer domain.GameOutputer,
+	store DailyStore,
+	recorder GameRecorder,
+) (err error) {
+	result, err := store.LoadDailyResult(settings.Date, settings.CollectionHash)
+	if err != nil {
+		return fmt.Errorf("load daily result: %w", err)
+	} else if result != nil {
+		slog.Info("Daily challenge is already played", slog.Any("result", result))
+		outputer.ShowDailyResult(result, true)
+
+		return nil
+	}
+
+	randomizer := NewDailyRandomizer(settings.Date, settings.CollectionHash, settings.Levels)
+
+	category, difficulty, err := randomizer.ChoiceList(settings.Categories)
+	if err != nil {
+		return fmt.Errorf("choice daily list: %w", err)
+	}
+
+	word, err := randomizer.ChoiceWord(category, difficulty)
+	if err != nil {
+		return fmt.Errorf("choice daily word: %w", err)
+	}
+
+	maxMistakes := settings.Levels.MaxMistakes(difficulty, settings.MaxMistakes)
+
+	game := domain.NewGame(word, category.Alphabet, maxMistakes, settings.WordGuessPenalty)
+	game.SetHintPolicy(settings.HintPolicies.For(difficulty))
+	game.Start()
+	slog.Info("Daily challenge started", slog.String("date", settings.Date), slog.Any("game", game))
+
+	// The challenge is kept as played before the first guess, so quitting does not give another try
+	if err := store.SaveDailyResult(domain.NewDailyResult(settings.Date, settings.CollectionHash, game)); err != nil {
+		return fmt.Errorf("save daily result: %w", err)
+	}
+
+	if err := playGame(game, inputer, outputer, noSave, nil); err != nil {
+		return err
+	}
+
+	result = domain.NewDailyResult(settings.Date, settings.CollectionHash, game)
+	if err := store.SaveDailyResult(result); err != nil {
+		return fmt.Errorf("save daily result: %w", err)
+	}
+
+	recordGame(&domain.SavedGame{Category: category.Name, Difficulty: difficulty, Levels: settings.Levels, Game: game}, recorder)
+	outputer.ShowDailyResult(result, false)
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package application
+
+import "makly/hangman/internal/domain"
+
+type GameSaver interface {
+	SaveGame(savedGame *domain.SavedGame) (err error)
+	RemoveSavedGame() (err error)
+	GameRecorder
+}
+
+type GameRecorder interface {
+	// RecordGame keeps a finished game with its events for replays and player statistics.
+	RecordGame(savedGame *domain.SavedGame) (err error)
+}
+
+type ResultRecorder interface {
+	// RecordResult adds the result of a game that has no saved game, like evil and hot seat games, to the player statistics.
+	// An empty player is the player of the session.
+	RecordResult(player string, result *domain.GameResult) (err error)
+}
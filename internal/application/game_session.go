@@ -4,41 +4,221 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"math/rand/v2"
 
 	"makly/hangman/internal/domain"
 )
 
+type GameSettings struct {
+	Category   *domain.Category
+	Difficulty domain.Difficulty
+	// Levels name the difficulty of the saved game.
+	Levels           domain.DifficultyLevels
+	MaxMistakes      int
+	WordGuessPenalty int
+	HintPolicy       domain.HintPolicy
+	// Tags filter the candidates of evil games, the other games get the filtered words from the word randomizer.
+	Tags domain.TagFilter
+	// PowerUps, Practice, Opponent and Random are not used by evil games.
+	PowerUps domain.PowerUps
+	Practice bool
+	// Opponent are the words known to the solver, it plays the word after the player when they are set.
+	Opponent []domain.Word
+	// Random chooses the bought letters.
+	Random *rand.Rand
+	// Timing is nil for games that are not timed.
+	Timing *Timing
+}
+
 func RunGameSession(
-	category *domain.Category,
-	difficulty domain.Difficulty,
-	maxMistakes int,
+	settings *GameSettings,
 	inputer domain.GameInputer,
 	outputer domain.GameOutputer,
 	wordRandomizer WordRandomizer,
+	saver GameSaver,
 ) (err error) {
-	word, err := wordRandomizer.ChoiceWord(category, difficulty)
+	word, err := wordRandomizer.ChoiceWord(settings.Category, settings.Difficulty)
 	if err != nil {
 		return fmt.Errorf("choice word: %w", err)
 	}
 
-	slog.Info("Random choose word", slog.String("word", word.Word))
+	slog.Info("Random choose word", slog.Any("word", word))
+
+	category := orEmptyCategory(settings.Category)
 
-	game := domain.NewGame(word, maxMistakes)
+	game := domain.NewGame(word, category.Alphabet, settings.MaxMistakes, settings.WordGuessPenalty)
+	game.SetHintPolicy(settings.HintPolicy)
+	game.SetPowerUps(settings.PowerUps)
+	game.SetPractice(settings.Practice)
+	game.SetRandom(settings.Random)
+	game.Start()
 	slog.Info("Game started", "game", game)
 
+	savedGame := &domain.SavedGame{
+		Category:   category.Name,
+		Difficulty: settings.Difficulty,
+		Levels:     settings.Levels,
+		Game:       game,
+	}
+
+	// Timed games are not saved, so the clock can not be stopped by resuming later
+	if settings.Timing.IsTimed() {
+		if err := playGame(game, inputer, outputer, noSave, settings.Timing); err != nil {
+			return err
+		}
+
+		recordGame(savedGame, saver)
+		playOpponent(settings, word, category.Alphabet, outputer)
+
+		return nil
+	}
+
+	if err := playSavedGame(savedGame, inputer, outputer, saver); err != nil {
+		return err
+	}
+
+	playOpponent(settings, word, category.Alphabet, outputer)
+
+	return nil
+}
+
+// playOpponent lets the solver play the same word within the same limits, so the player can compare the results.
+func playOpponent(settings *GameSettings, word *domain.Word, alphabet *domain.Alphabet, outputer domain.GameOutputer) {
+	if settings.Opponent == nil {
+		return
+	}
+
+	game, solver := SolveWord(*word, settings.Opponent, alphabet, settings.MaxMistakes, settings.WordGuessPenalty)
+	slog.Info("Opponent played", slog.Any("game", game))
+
+	outputer.ShowOpponentResult(game, solver.Sequence())
+}
+
+func RunEvilGameSession(
+	settings *GameSettings,
+	inputer domain.GameInputer,
+	outputer domain.GameOutputer,
+	wordRandomizer WordRandomizer,
+	recorder ResultRecorder,
+) (err error) {
+	seed, err := wordRandomizer.ChoiceWord(settings.Category, settings.Difficulty)
+	if err != nil {
+		return fmt.Errorf("choice seed word: %w", err)
+	}
+
+	category, difficulty := orEmptyCategory(settings.Category), settings.Difficulty
+
+	// The seed word is chosen with the same tags, so the candidates are filtered by them too
+	game := domain.NewEvilGame(
+		seed, settings.Tags.Filter(category.WordsByDifficulty(difficulty)), category.Alphabet, settings.MaxMistakes,
+		settings.WordGuessPenalty,
+	)
+	game.SetHintPolicy(settings.HintPolicy)
+	slog.Info("Evil game started", slog.Any("game", game))
+
+	// Evil games are not saved because the secret word is not fixed
+	if err := playGame(game, inputer, outputer, noSave, settings.Timing); err != nil {
+		return err
+	}
+
+	recordResult("", domain.NewEvilGameResult(category.Name, settings.Levels.Name(difficulty), game), recorder)
+
+	return nil
+}
+
+// orEmptyCategory leaves a nil category to the word randomizer, the game is played in the latin alphabet then.
+func orEmptyCategory(category *domain.Category) *domain.Category {
+	if category == nil {
+		return &domain.Category{}
+	}
+
+	return category
+}
+
+func ResumeGameSession(
+	savedGame *domain.SavedGame,
+	random *rand.Rand,
+	inputer domain.GameInputer,
+	outputer domain.GameOutputer,
+	saver GameSaver,
+) (err error) {
+	slog.Info("Game resumed", slog.Any("saved game", savedGame))
+
+	savedGame.Game.SetRandom(random)
+
+	return playSavedGame(savedGame, inputer, outputer, saver)
+}
+
+func playSavedGame(
+	savedGame *domain.SavedGame,
+	inputer domain.GameInputer,
+	outputer domain.GameOutputer,
+	saver GameSaver,
+) (err error) {
+	save := func() error {
+		return saver.SaveGame(savedGame)
+	}
+
+	if err := save(); err != nil {
+		return fmt.Errorf("save game: %w", err)
+	}
+
+	if err := playGame(savedGame.Game, inputer, outputer, save, nil); err != nil {
+		return err
+	}
+
+	if err := saver.RemoveSavedGame(); err != nil {
+		return fmt.Errorf("remove saved game: %w", err)
+	}
+
+	recordGame(savedGame, saver)
+
+	return nil
+}
+
+// recordGame does not fail the session, because the game is already played.
+func recordGame(savedGame *domain.SavedGame, recorder GameRecorder) {
+	if err := recorder.RecordGame(savedGame); err != nil {
+		slog.Warn("Game is not recorded", slog.Any("error", err))
+	}
+}
+
+// recordResult does not fail the session, because the game is already played.
+func recordResult(player string, result *domain.GameResult, recorder ResultRecorder) {
+	if err := recorder.RecordResult(player, result); err != nil {
+		slog.Warn("Game result is not recorded", slog.String("player", player), slog.Any("error", err))
+	}
+}
+
+func noSave() error {
+	return nil
+}
+
+func playGame(
+	game domain.GameEngine,
+	inputer domain.GameInputer,
+	outputer domain.GameOutputer,
+	save func() error,
+	timing *Timing,
+) (err error) {
 	reshow := true
 
+	var timer *gameTimer
+	if timing.IsTimed() {
+		timer = newGameTimer(timing)
+	}
+
 	for !game.IsFinished() {
 		if reshow {
 			outputer.ShowGame(game)
 			slog.Info("Reshow game", "game", game)
 		}
 
-		letter, err := inputer.GetLetter()
+		guess, err := getGuess(inputer, outputer, timer)
 		if err != nil {
 			var inputerError *domain.InputerError
 			if errors.As(err, &inputerError) {
-				slog.Error("Getting letter to guess", slog.Any("error", err))
+				slog.Error("Getting guess", slog.Any("error", err))
 
 				reshow = false
 
@@ -47,12 +227,16 @@ func RunGameSession(
 				continue
 			}
 
-			return fmt.Errorf("getting letter to guess: %w", err)
+			return fmt.Errorf("getting guess: %w", err)
 		}
 
-		slog.Info("Got correct letter to guess", slog.String("Letter", string(letter)))
+		slog.Info("Got correct guess", slog.Any("guess", guess))
+
+		game.MakeGuess(guess)
 
-		game.Guess(letter)
+		if err := save(); err != nil {
+			return fmt.Errorf("save game: %w", err)
+		}
 
 		reshow = true
 	}
@@ -62,3 +246,11 @@ func RunGameSession(
 
 	return nil
 }
+
+func getGuess(inputer domain.GameInputer, outputer domain.GameOutputer, timer *gameTimer) (guess *domain.Guess, err error) {
+	if timer == nil {
+		return inputer.GetGuess()
+	}
+
+	return timer.getGuess(inputer, outputer)
+}
@@ -0,0 +1,105 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+
+	"makly/hangman/internal/domain"
+)
+
+type HotSeatSettings struct {
+	Category   *domain.Category
+	Difficulty domain.Difficulty
+	// Levels name the difficulty in the player statistics.
+	Levels           domain.DifficultyLevels
+	MaxMistakes      int
+	WordGuessPenalty int
+	Players          []string
+	// MistakesRule decides whether maxMistakes is shared by the players or given to every one of them.
+	MistakesRule domain.MistakesRule
+	HintPolicy   domain.HintPolicy
+	// PowerUps are shared by the players.
+	PowerUps domain.PowerUps
+	// Random chooses the bought letters.
+	Random *rand.Rand
+}
+
+func RunHotSeatSession(
+	settings *HotSeatSettings,
+	inputer domain.GameInputer,
+	outputer domain.GameOutputer,
+	wordRandomizer WordRandomizer,
+	recorder ResultRecorder,
+) (err error) {
+	word, err := wordRandomizer.ChoiceWord(settings.Category, settings.Difficulty)
+	if err != nil {
+		return fmt.Errorf("choice word: %w", err)
+	}
+
+	hotSeat, err := domain.NewHotSeatGame(
+		word,
+		orEmptyCategory(settings.Category).Alphabet,
+		settings.MaxMistakes,
+		settings.WordGuessPenalty,
+		settings.Players,
+		settings.MistakesRule,
+	)
+	if err != nil {
+		return fmt.Errorf("new hot seat game: %w", err)
+	}
+
+	hotSeat.Game().SetHintPolicy(settings.HintPolicy)
+	hotSeat.Game().SetPowerUps(settings.PowerUps)
+	hotSeat.Game().SetRandom(settings.Random)
+
+	slog.Info("Hot seat game started", slog.Any("hot seat", hotSeat))
+
+	reshow := true
+
+	for !hotSeat.IsFinished() {
+		if reshow {
+			outputer.ShowHotSeatGame(hotSeat)
+		}
+
+		guess, err := inputer.GetGuess()
+		if err != nil {
+			var inputerError *domain.InputerError
+			if errors.As(err, &inputerError) {
+				slog.Error("Getting guess", slog.Any("error", err))
+
+				reshow = false
+
+				outputer.ShowInputError(err)
+
+				continue
+			}
+
+			return fmt.Errorf("getting guess: %w", err)
+		}
+
+		slog.Info("Got guess from player", slog.String("player", hotSeat.CurrentPlayer().Name()), slog.Any("guess", guess))
+
+		hotSeat.MakeGuess(guess)
+
+		reshow = true
+	}
+
+	outputer.ShowHotSeatGame(hotSeat)
+	outputer.ShowHotSeatResult(hotSeat)
+
+	recordPlayerResults(settings, hotSeat, recorder)
+
+	return nil
+}
+
+// recordPlayerResults gives every player the result to the profile of the same name.
+func recordPlayerResults(settings *HotSeatSettings, hotSeat *domain.HotSeatGame, recorder ResultRecorder) {
+	category, difficulty := orEmptyCategory(settings.Category).Name, settings.Levels.Name(settings.Difficulty)
+
+	for i := range hotSeat.Players() {
+		player := &hotSeat.Players()[i]
+		recordResult(player.Name(), domain.NewPlayerResult(category, difficulty, hotSeat, player), recorder)
+	}
+}
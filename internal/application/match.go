@@ -0,0 +1,122 @@
+package application
+
+import (
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+
+	"makly/hangman/internal/domain"
+)
+
+type MatchSettings struct {
+	Rounds     int
+	Categories []domain.Category
+	// Category is nil when every round is played in a randomly chosen category.
+	Category *domain.Category
+	// Difficulty is domain.UnknownDifficulty when every round is played with a randomly chosen difficulty
+	// and domain.AdaptiveDifficulty when the difficulty of every round follows the results of the last rounds.
+	Difficulty domain.Difficulty
+	// Levels are the difficulty levels of the collection.
+	Levels domain.DifficultyLevels
+	// MaxMistakes is zero when every round uses the maxMistakes of its level.
+	MaxMistakes      int
+	WordGuessPenalty int
+	// HintPolicies choose the hint policy by the difficulty of every round, nil means domain.DefaultHintPolicy.
+	HintPolicies *domain.HintPolicies
+	// PowerUps are given anew in every round.
+	PowerUps domain.PowerUps
+	// Timing limits every round separately.
+	Timing *Timing
+	// Recorder records every finished round, nil means rounds are not recorded.
+	Recorder GameRecorder
+	// Random chooses the categories and difficulties of the rounds and the bought letters.
+	Random *rand.Rand
+}
+
+func roundCategoryAndDifficulty(
+	settings *MatchSettings,
+	match *domain.Match,
+) (category *domain.Category, difficulty domain.Difficulty, err error) {
+	category, difficulty = settings.Category, settings.Difficulty
+
+	if category == nil {
+		category, err = ChoiceCategory(settings.Categories, settings.Random)
+		if err != nil {
+			return nil, domain.UnknownDifficulty, fmt.Errorf("random choose round category: %w", err)
+		}
+	}
+
+	switch {
+	case difficulty == domain.UnknownDifficulty:
+		difficulty = ChoiceDifficulty(settings.Levels, settings.Random)
+	case difficulty == domain.AdaptiveDifficulty:
+		difficulty = match.AdaptiveLevel().Difficulty()
+	}
+
+	return category, difficulty, nil
+}
+
+func RunMatch(
+	settings *MatchSettings,
+	inputer domain.GameInputer,
+	outputer domain.GameOutputer,
+	wordRandomizer WordRandomizer,
+) (match *domain.Match, err error) {
+	match = domain.NewMatch(settings.Rounds, settings.Levels)
+	if settings.Difficulty == domain.AdaptiveDifficulty {
+		match.SetAdaptiveLevel(domain.NewAdaptiveLevel(settings.Levels))
+	}
+
+	slog.Info("Match started", slog.Any("match", match))
+
+	for !match.IsFinished() {
+		category, difficulty, err := roundCategoryAndDifficulty(settings, match)
+		if err != nil {
+			return nil, fmt.Errorf("round %d: %w", match.CurrentRound(), err)
+		}
+
+		word, err := wordRandomizer.ChoiceWord(category, difficulty)
+		if err != nil {
+			return nil, fmt.Errorf("round %d: choice word: %w", match.CurrentRound(), err)
+		}
+
+		maxMistakes := settings.Levels.MaxMistakes(difficulty, settings.MaxMistakes)
+
+		game := domain.NewGame(word, category.Alphabet, maxMistakes, settings.WordGuessPenalty)
+		game.SetHintPolicy(settings.HintPolicies.For(difficulty))
+		game.SetPowerUps(settings.PowerUps)
+		game.SetRandom(settings.Random)
+		game.Start()
+		slog.Info("Round started", slog.Int("round", match.CurrentRound()), slog.Any("game", game))
+
+		// Single rounds are not resumable, so nothing is saved during a match
+		if err := playGame(game, inputer, outputer, noSave, settings.Timing); err != nil {
+			return nil, fmt.Errorf("round %d: %w", match.CurrentRound(), err)
+		}
+
+		if settings.Recorder != nil {
+			recordGame(&domain.SavedGame{
+				Category:   category.Name,
+				Difficulty: difficulty,
+				Levels:     settings.Levels,
+				Game:       game,
+			}, settings.Recorder)
+		}
+
+		result := domain.NewRoundResult(match.CurrentRound(), category.Name, difficulty, game)
+		match.AddResult(result)
+		slog.Info("Round finished", slog.Any("result", result), slog.Any("match", match))
+
+		outputer.ShowRoundSummary(match)
+
+		if !match.IsFinished() {
+			if err := inputer.WaitContinue(); err != nil {
+				return nil, fmt.Errorf("waiting for next round: %w", err)
+			}
+		}
+	}
+
+	slog.Info("Match finished", slog.Any("match", match))
+
+	return match, nil
+}
@@ -0,0 +1,141 @@
+// Code generated by mockery v2.46.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "makly/hangman/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DailyStore is an autogenerated mock type for the DailyStore type
+type DailyStore struct {
+	mock.Mock
+}
+
+type DailyStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DailyStore) EXPECT() *DailyStore_Expecter {
+	return &DailyStore_Expecter{mock: &_m.Mock}
+}
+
+// LoadDailyResult provides a mock function with given fields: date, collection
+func (_m *DailyStore) LoadDailyResult(date string, collection string) (*domain.DailyResult, error) {
+	ret := _m.Called(date, collection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadDailyResult")
+	}
+
+	var r0 *domain.DailyResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*domain.DailyResult, error)); ok {
+		return rf(date, collection)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *domain.DailyResult); ok {
+		r0 = rf(date, collection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DailyResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(date, collection)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DailyStore_LoadDailyResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadDailyResult'
+type DailyStore_LoadDailyResult_Call struct {
+	*mock.Call
+}
+
+// LoadDailyResult is a helper method to define mock.On call
+//   - date string
+//   - collection string
+func (_e *DailyStore_Expecter) LoadDailyResult(date interface{}, collection interface{}) *DailyStore_LoadDailyResult_Call {
+	return &DailyStore_LoadDailyResult_Call{Call: _e.mock.On("LoadDailyResult", date, collection)}
+}
+
+func (_c *DailyStore_LoadDailyResult_Call) Run(run func(date string, collection string)) *DailyStore_LoadDailyResult_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DailyStore_LoadDailyResult_Call) Return(result *domain.DailyResult, err error) *DailyStore_LoadDailyResult_Call {
+	_c.Call.Return(result, err)
+	return _c
+}
+
+func (_c *DailyStore_LoadDailyResult_Call) RunAndReturn(run func(string, string) (*domain.DailyResult, error)) *DailyStore_LoadDailyResult_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveDailyResult provides a mock function with given fields: result
+func (_m *DailyStore) SaveDailyResult(result *domain.DailyResult) error {
+	ret := _m.Called(result)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveDailyResult")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.DailyResult) error); ok {
+		r0 = rf(result)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DailyStore_SaveDailyResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDailyResult'
+type DailyStore_SaveDailyResult_Call struct {
+	*mock.Call
+}
+
+// SaveDailyResult is a helper method to define mock.On call
+//   - result *domain.DailyResult
+func (_e *DailyStore_Expecter) SaveDailyResult(result interface{}) *DailyStore_SaveDailyResult_Call {
+	return &DailyStore_SaveDailyResult_Call{Call: _e.mock.On("SaveDailyResult", result)}
+}
+
+func (_c *DailyStore_SaveDailyResult_Call) Run(run func(result *domain.DailyResult)) *DailyStore_SaveDailyResult_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*domain.DailyResult))
+	})
+	return _c
+}
+
+func (_c *DailyStore_SaveDailyResult_Call) Return(err error) *DailyStore_SaveDailyResult_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DailyStore_SaveDailyResult_Call) RunAndReturn(run func(*domain.DailyResult) error) *DailyStore_SaveDailyResult_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDailyStore creates a new instance of DailyStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDailyStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DailyStore {
+	mock := &DailyStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,82 @@
+// Code generated by mockery v2.46.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "makly/hangman/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GameRecorder is an autogenerated mock type for the GameRecorder type
+type GameRecorder struct {
+	mock.Mock
+}
+
+type GameRecorder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GameRecorder) EXPECT() *GameRecorder_Expecter {
+	return &GameRecorder_Expecter{mock: &_m.Mock}
+}
+
+// RecordGame provides a mock function with given fields: savedGame
+func (_m *GameRecorder) RecordGame(savedGame *domain.SavedGame) error {
+	ret := _m.Called(savedGame)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordGame")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.SavedGame) error); ok {
+		r0 = rf(savedGame)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GameRecorder_RecordGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordGame'
+type GameRecorder_RecordGame_Call struct {
+	*mock.Call
+}
+
+// RecordGame is a helper method to define mock.On call
+//   - savedGame *domain.SavedGame
+func (_e *GameRecorder_Expecter) RecordGame(savedGame interface{}) *GameRecorder_RecordGame_Call {
+	return &GameRecorder_RecordGame_Call{Call: _e.mock.On("RecordGame", savedGame)}
+}
+
+func (_c *GameRecorder_RecordGame_Call) Run(run func(savedGame *domain.SavedGame)) *GameRecorder_RecordGame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*domain.SavedGame))
+	})
+	return _c
+}
+
+func (_c *GameRecorder_RecordGame_Call) Return(err error) *GameRecorder_RecordGame_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *GameRecorder_RecordGame_Call) RunAndReturn(run func(*domain.SavedGame) error) *GameRecorder_RecordGame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewGameRecorder creates a new instance of GameRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGameRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GameRecorder {
+	mock := &GameRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
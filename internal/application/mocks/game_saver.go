@@ -0,0 +1,173 @@
+// Code generated by mockery v2.46.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "makly/hangman/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GameSaver is an autogenerated mock type for the GameSaver type
+type GameSaver struct {
+	mock.Mock
+}
+
+type GameSaver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GameSaver) EXPECT() *GameSaver_Expecter {
+	return &GameSaver_Expecter{mock: &_m.Mock}
+}
+
+// RecordGame provides a mock function with given fields: savedGame
+func (_m *GameSaver) RecordGame(savedGame *domain.SavedGame) error {
+	ret := _m.Called(savedGame)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordGame")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.SavedGame) error); ok {
+		r0 = rf(savedGame)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GameSaver_RecordGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordGame'
+type GameSaver_RecordGame_Call struct {
+	*mock.Call
+}
+
+// RecordGame is a helper method to define mock.On call
+//   - savedGame *domain.SavedGame
+func (_e *GameSaver_Expecter) RecordGame(savedGame interface{}) *GameSaver_RecordGame_Call {
+	return &GameSaver_RecordGame_Call{Call: _e.mock.On("RecordGame", savedGame)}
+}
+
+func (_c *GameSaver_RecordGame_Call) Run(run func(savedGame *domain.SavedGame)) *GameSaver_RecordGame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*domain.SavedGame))
+	})
+	return _c
+}
+
+func (_c *GameSaver_RecordGame_Call) Return(err error) *GameSaver_RecordGame_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *GameSaver_RecordGame_Call) RunAndReturn(run func(*domain.SavedGame) error) *GameSaver_RecordGame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveSavedGame provides a mock function with given fields:
+func (_m *GameSaver) RemoveSavedGame() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveSavedGame")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GameSaver_RemoveSavedGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSavedGame'
+type GameSaver_RemoveSavedGame_Call struct {
+	*mock.Call
+}
+
+// RemoveSavedGame is a helper method to define mock.On call
+func (_e *GameSaver_Expecter) RemoveSavedGame() *GameSaver_RemoveSavedGame_Call {
+	return &GameSaver_RemoveSavedGame_Call{Call: _e.mock.On("RemoveSavedGame")}
+}
+
+func (_c *GameSaver_RemoveSavedGame_Call) Run(run func()) *GameSaver_RemoveSavedGame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameSaver_RemoveSavedGame_Call) Return(err error) *GameSaver_RemoveSavedGame_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *GameSaver_RemoveSavedGame_Call) RunAndReturn(run func() error) *GameSaver_RemoveSavedGame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveGame provides a mock function with given fields: savedGame
+func (_m *GameSaver) SaveGame(savedGame *domain.SavedGame) error {
+	ret := _m.Called(savedGame)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveGame")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.SavedGame) error); ok {
+		r0 = rf(savedGame)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GameSaver_SaveGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveGame'
+type GameSaver_SaveGame_Call struct {
+	*mock.Call
+}
+
+// SaveGame is a helper method to define mock.On call
+//   - savedGame *domain.SavedGame
+func (_e *GameSaver_Expecter) SaveGame(savedGame interface{}) *GameSaver_SaveGame_Call {
+	return &GameSaver_SaveGame_Call{Call: _e.mock.On("SaveGame", savedGame)}
+}
+
+func (_c *GameSaver_SaveGame_Call) Run(run func(savedGame *domain.SavedGame)) *GameSaver_SaveGame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*domain.SavedGame))
+	})
+	return _c
+}
+
+func (_c *GameSaver_SaveGame_Call) Return(err error) *GameSaver_SaveGame_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *GameSaver_SaveGame_Call) RunAndReturn(run func(*domain.SavedGame) error) *GameSaver_SaveGame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewGameSaver creates a new instance of GameSaver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGameSaver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GameSaver {
+	mock := &GameSaver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery v2.46.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "makly/hangman/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ResultRecorder is an autogenerated mock type for the ResultRecorder type
+type ResultRecorder struct {
+	mock.Mock
+}
+
+type ResultRecorder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ResultRecorder) EXPECT() *ResultRecorder_Expecter {
+	return &ResultRecorder_Expecter{mock: &_m.Mock}
+}
+
+// RecordResult provides a mock function with given fields: player, result
+func (_m *ResultRecorder) RecordResult(player string, result *domain.GameResult) error {
+	ret := _m.Called(player, result)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordResult")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *domain.GameResult) error); ok {
+		r0 = rf(player, result)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResultRecorder_RecordResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordResult'
+type ResultRecorder_RecordResult_Call struct {
+	*mock.Call
+}
+
+// RecordResult is a helper method to define mock.On call
+//   - player string
+//   - result *domain.GameResult
+func (_e *ResultRecorder_Expecter) RecordResult(player interface{}, result interface{}) *ResultRecorder_RecordResult_Call {
+	return &ResultRecorder_RecordResult_Call{Call: _e.mock.On("RecordResult", player, result)}
+}
+
+func (_c *ResultRecorder_RecordResult_Call) Run(run func(player string, result *domain.GameResult)) *ResultRecorder_RecordResult_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*domain.GameResult))
+	})
+	return _c
+}
+
+func (_c *ResultRecorder_RecordResult_Call) Return(err error) *ResultRecorder_RecordResult_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ResultRecorder_RecordResult_Call) RunAndReturn(run func(string, *domain.GameResult) error) *ResultRecorder_RecordResult_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewResultRecorder creates a new instance of ResultRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewResultRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ResultRecorder {
+	mock := &ResultRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,141 @@
+// Code generated by mockery v2.46.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "makly/hangman/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WordHistoryStore is an autogenerated mock type for the WordHistoryStore type
+type WordHistoryStore struct {
+	mock.Mock
+}
+
+type WordHistoryStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WordHistoryStore) EXPECT() *WordHistoryStore_Expecter {
+	return &WordHistoryStore_Expecter{mock: &_m.Mock}
+}
+
+// LoadWordHistory provides a mock function with given fields: collection
+func (_m *WordHistoryStore) LoadWordHistory(collection string) (*domain.WordHistory, error) {
+	ret := _m.Called(collection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadWordHistory")
+	}
+
+	var r0 *domain.WordHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*domain.WordHistory, error)); ok {
+		return rf(collection)
+	}
+	if rf, ok := ret.Get(0).(func(string) *domain.WordHistory); ok {
+		r0 = rf(collection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WordHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(collection)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WordHistoryStore_LoadWordHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadWordHistory'
+type WordHistoryStore_LoadWordHistory_Call struct {
+	*mock.Call
+}
+
+// LoadWordHistory is a helper method to define mock.On call
+//   - collection string
+func (_e *WordHistoryStore_Expecter) LoadWordHistory(collection interface{}) *WordHistoryStore_LoadWordHistory_Call {
+	return &WordHistoryStore_LoadWordHistory_Call{Call: _e.mock.On("LoadWordHistory", collection)}
+}
+
+func (_c *WordHistoryStore_LoadWordHistory_Call) Run(run func(collection string)) *WordHistoryStore_LoadWordHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *WordHistoryStore_LoadWordHistory_Call) Return(history *domain.WordHistory, err error) *WordHistoryStore_LoadWordHistory_Call {
+	_c.Call.Return(history, err)
+	return _c
+}
+
+func (_c *WordHistoryStore_LoadWordHistory_Call) RunAndReturn(run func(string) (*domain.WordHistory, error)) *WordHistoryStore_LoadWordHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveWordHistory provides a mock function with given fields: collection, history
+func (_m *WordHistoryStore) SaveWordHistory(collection string, history *domain.WordHistory) error {
+	ret := _m.Called(collection, history)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveWordHistory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *domain.WordHistory) error); ok {
+		r0 = rf(collection, history)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WordHistoryStore_SaveWordHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveWordHistory'
+type WordHistoryStore_SaveWordHistory_Call struct {
+	*mock.Call
+}
+
+// SaveWordHistory is a helper method to define mock.On call
+//   - collection string
+//   - history *domain.WordHistory
+func (_e *WordHistoryStore_Expecter) SaveWordHistory(collection interface{}, history interface{}) *WordHistoryStore_SaveWordHistory_Call {
+	return &WordHistoryStore_SaveWordHistory_Call{Call: _e.mock.On("SaveWordHistory", collection, history)}
+}
+
+func (_c *WordHistoryStore_SaveWordHistory_Call) Run(run func(collection string, history *domain.WordHistory)) *WordHistoryStore_SaveWordHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*domain.WordHistory))
+	})
+	return _c
+}
+
+func (_c *WordHistoryStore_SaveWordHistory_Call) Return(err error) *WordHistoryStore_SaveWordHistory_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *WordHistoryStore_SaveWordHistory_Call) RunAndReturn(run func(string, *domain.WordHistory) error) *WordHistoryStore_SaveWordHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWordHistoryStore creates a new instance of WordHistoryStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWordHistoryStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WordHistoryStore {
+	mock := &WordHistoryStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,33 @@
+package application
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"makly/hangman/internal/domain"
+)
+
+// ReplayStepDelay is how long every event of a replayed game is shown.
+const ReplayStepDelay = time.Second
+
+func RunReplay(savedGame *domain.SavedGame, outputer domain.GameOutputer, clock domain.Clock) (err error) {
+	slog.Info("Replay started", slog.Any("saved game", savedGame))
+
+	game, err := domain.ReplayEvents(savedGame.Game.Events(), func(game *domain.Game, event *domain.Event) {
+		outputer.ShowReplayStep(game, event)
+		<-clock.After(ReplayStepDelay)
+	})
+	if err != nil {
+		return fmt.Errorf("replay events: %w", err)
+	}
+
+	// Saved games that are not finished yet can be replayed too
+	if game.IsFinished() {
+		outputer.ShowGameResult(game)
+	}
+
+	slog.Info("Replay finished", slog.Any("game", game))
+
+	return nil
+}
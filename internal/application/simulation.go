@@ -0,0 +1,196 @@
+package application
+
+import (
+	"log/slog"
+	"math/rand/v2"
+	"sort"
+
+	"makly/hangman/internal/domain"
+)
+
+const DefaultSimulationRuns = 100
+
+type SimulationSettings struct {
+	Runs int
+	// MaxMistakes overrides the maxMistakes of the levels when it is set.
+	MaxMistakes      int
+	WordGuessPenalty int
+	// Levels name the declared and measured difficulties in the report, words are played within the maxMistakes of their levels.
+	Levels domain.DifficultyLevels
+}
+
+type WordStats struct {
+	Category string
+	Word     string
+	Declared domain.Difficulty
+	// MaxMistakes is the limit of the declared level the word is played within.
+	MaxMistakes int
+	// Measured is the bucket the word falls into when the collection is ranked by its difficulty score.
+	Measured    domain.Difficulty
+	Runs        int
+	Wins        int
+	WinRate     float64
+	AvgMistakes float64
+	AvgAttempts float64
+	Score       float64
+}
+
+func (w *WordStats) IsMismatch() bool {
+	return w.Declared != w.Measured
+}
+
+type CategoryStats struct {
+	Category    string
+	Words       int
+	Runs        int
+	WinRate     float64
+	AvgMistakes float64
+	AvgAttempts float64
+	Mismatches  int
+}
+
+type SimulationReport struct {
+	Runs       int
+	Levels     domain.DifficultyLevels
+	Words      []WordStats
+	Categories []CategoryStats
+}
+
+func (r *SimulationReport) Mismatches() []WordStats {
+	mismatches := make([]WordStats, 0)
+
+	for _, word := range r.Words {
+		if word.IsMismatch() {
+			mismatches = append(mismatches, word)
+		}
+	}
+
+	return mismatches
+}
+
+func SimulateWord(
+	word domain.Word, words []domain.Word, alphabet *domain.Alphabet, settings *SimulationSettings, random *rand.Rand,
+) WordStats {
+	stats := WordStats{Runs: settings.Runs, Word: word.Word, MaxMistakes: settings.MaxMistakes}
+	mistakes, attempts := 0, 0
+
+	for range settings.Runs {
+		game := domain.NewGame(&word, alphabet, settings.MaxMistakes, settings.WordGuessPenalty)
+		playSolver(game, NewRandomSolver(words, game, random))
+
+		// The word is reported the same way as it is played
+		stats.Word = game.Alphabet().FoldWord(word.Word)
+
+		if game.IsWin() {
+			stats.Wins++
+		}
+
+		mistakes += game.Mistakes()
+		attempts += game.Attempts()
+	}
+
+	if settings.Runs > 0 {
+		stats.WinRate = float64(stats.Wins) / float64(settings.Runs)
+		stats.AvgMistakes = float64(mistakes) / float64(settings.Runs)
+		stats.AvgAttempts = float64(attempts) / float64(settings.Runs)
+	}
+
+	// Lost games weigh as much as running out of mistakes once more
+	stats.Score = stats.AvgMistakes/float64(settings.MaxMistakes) + (1 - stats.WinRate)
+
+	return stats
+}
+
+func SimulateCollection(categories []domain.Category, settings *SimulationSettings, random *rand.Rand) *SimulationReport {
+	words := CollectionWords(categories)
+	report := &SimulationReport{
+		Runs:       settings.Runs,
+		Levels:     settings.Levels,
+		Words:      make([]WordStats, 0, len(words)),
+		Categories: make([]CategoryStats, 0, len(categories)),
+	}
+
+	for _, category := range categories {
+		for level, levelWords := range category.WordsByLevel {
+			levelSettings := *settings
+			levelSettings.MaxMistakes = settings.Levels.MaxMistakes(domain.Difficulty(level), settings.MaxMistakes)
+
+			for _, word := range levelWords {
+				stats := SimulateWord(word, words, category.Alphabet, &levelSettings, random)
+				stats.Category = category.Name
+				stats.Declared = domain.Difficulty(level)
+
+				report.Words = append(report.Words, stats)
+			}
+		}
+	}
+
+	classifyMeasured(report.Words, settings.Levels)
+
+	for _, category := range categories {
+		report.Categories = append(report.Categories, categoryStats(category.Name, report.Words))
+	}
+
+	slog.Info("Collection simulated",
+		slog.Int("words", len(report.Words)),
+		slog.Int("runs", settings.Runs),
+		slog.Int("mismatches", len(report.Mismatches())))
+
+	return report
+}
+
+// classifyMeasured ranks words by their score and splits them into buckets of the same sizes as the declared ones,
+// so the measured difficulty is relative to the collection and does not depend on how strong the solver is.
+func classifyMeasured(words []WordStats, levels domain.DifficultyLevels) {
+	order := make([]int, len(words))
+	bucketSizes := make([]int, levels.Count())
+
+	for i := range words {
+		order[i] = i
+		bucketSizes[words[i].Declared]++
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return words[order[i]].Score < words[order[j]].Score
+	})
+
+	difficulty := domain.EasyDifficulty
+
+	for _, i := range order {
+		for bucketSizes[difficulty] == 0 {
+			difficulty++
+		}
+
+		words[i].Measured = difficulty
+		bucketSizes[difficulty]--
+	}
+}
+
+func categoryStats(name string, words []WordStats) CategoryStats {
+	stats := CategoryStats{Category: name}
+	wins, mistakes, attempts := 0, 0.0, 0.0
+
+	for _, word := range words {
+		if word.Category != name {
+			continue
+		}
+
+		stats.Words++
+		stats.Runs += word.Runs
+		wins += word.Wins
+		mistakes += word.AvgMistakes * float64(word.Runs)
+		attempts += word.AvgAttempts * float64(word.Runs)
+
+		if word.IsMismatch() {
+			stats.Mismatches++
+		}
+	}
+
+	if stats.Runs > 0 {
+		stats.WinRate = float64(wins) / float64(stats.Runs)
+		stats.AvgMistakes = mistakes / float64(stats.Runs)
+		stats.AvgAttempts = attempts / float64(stats.Runs)
+	}
+
+	return stats
+}
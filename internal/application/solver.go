@@ -0,0 +1,242 @@
+package application
+
+import (
+	"log/slog"
+	"math/rand/v2"
+
+	"makly/hangman/internal/domain"
+)
+
+// letterFrequencyOrder is tried first when none of the known words matches the pattern.
+const letterFrequencyOrder = "etaoinshrdlcumwfgypbvkjxqz"
+
+// Solver plays as an AI player, so it must stay a domain.GameInputer.
+var _ domain.GameInputer = (*Solver)(nil)
+
+type Solver struct {
+	words        []string
+	game         domain.GameView
+	guessedWords map[string]bool
+	sequence     []string
+	// random breaks ties between equally common letters; ties go to the alphabetically first letter when it is nil.
+	random *rand.Rand
+}
+
+func NewSolver(words []domain.Word, game domain.GameView) *Solver {
+	normalizedWords := make([]string, 0, len(words))
+	for _, word := range words {
+		normalizedWords = append(normalizedWords, game.Alphabet().NormalizeWord(word.Word))
+	}
+
+	return &Solver{
+		words:        normalizedWords,
+		game:         game,
+		guessedWords: make(map[string]bool),
+		sequence:     make([]string, 0),
+	}
+}
+
+func NewRandomSolver(words []domain.Word, game domain.GameView, random *rand.Rand) *Solver {
+	solver := NewSolver(words, game)
+	solver.random = random
+
+	return solver
+}
+
+func CollectionWords(categories []domain.Category) []domain.Word {
+	words := make([]domain.Word, 0)
+
+	for _, category := range categories {
+		for _, levelWords := range category.WordsByLevel {
+			words = append(words, levelWords...)
+		}
+	}
+
+	return words
+}
+
+func (s *Solver) Sequence() []string {
+	return s.sequence
+}
+
+func matchesPattern(word string, pattern []rune, used map[rune]bool, alphabet *domain.Alphabet) bool {
+	wordRunes := []rune(word)
+	if len(wordRunes) != len(pattern) {
+		return false
+	}
+
+	for i, letter := range wordRunes {
+		if pattern[i] == domain.HiddenLetter {
+			// Hidden position can not contain a letter that was already tried
+			if used[letter] || !alphabet.IsLetter(letter) {
+				return false
+			}
+
+			continue
+		}
+
+		if alphabet.Normalize(pattern[i]) != letter {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Solver) Candidates() []string {
+	pattern := []rune(s.game.Pattern())
+	used := s.game.Used()
+	candidates := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, word := range s.words {
+		if seen[word] || s.guessedWords[word] || !matchesPattern(word, pattern, used, s.game.Alphabet()) {
+			continue
+		}
+
+		seen[word] = true
+
+		candidates = append(candidates, word)
+	}
+
+	return candidates
+}
+
+func (s *Solver) chooseLetter(candidates []string) rune {
+	used := s.game.Used()
+	counts := make(map[rune]int)
+
+	for _, word := range candidates {
+		counted := make(map[rune]bool)
+
+		for _, letter := range word {
+			if s.game.Alphabet().IsLetter(letter) && !used[letter] && !counted[letter] {
+				counted[letter] = true
+				counts[letter]++
+			}
+		}
+	}
+
+	best := make([]rune, 0)
+
+	for _, letter := range s.game.Alphabet().Letters() {
+		switch {
+		case counts[letter] == 0:
+			continue
+		case len(best) == 0 || counts[letter] > counts[best[0]]:
+			best = []rune{letter}
+		case counts[letter] == counts[best[0]]:
+			best = append(best, letter)
+		}
+	}
+
+	if len(best) > 0 {
+		if s.random != nil {
+			return best[s.random.IntN(len(best))]
+		}
+
+		return best[0]
+	}
+
+	for _, letter := range letterFrequencyOrder + string(s.game.Alphabet().Letters()) {
+		if s.game.Alphabet().IsLetter(letter) && !used[letter] {
+			return letter
+		}
+	}
+
+	return s.game.Alphabet().Letters()[0]
+}
+
+func (s *Solver) GetLetter() (letter rune, err error) {
+	letter = s.chooseLetter(s.Candidates())
+	s.sequence = append(s.sequence, string(letter))
+
+	slog.Debug("Solver chose letter", domain.SecretAttr("letter", string(letter)))
+
+	return letter, nil
+}
+
+func (s *Solver) GetGuess() (guess *domain.Guess, err error) {
+	candidates := s.Candidates()
+	if len(candidates) == 1 {
+		s.guessedWords[candidates[0]] = true
+		s.sequence = append(s.sequence, candidates[0])
+
+		slog.Debug("Solver guessed word", domain.SecretAttr("word", candidates[0]))
+
+		return domain.NewWordGuess(candidates[0]), nil
+	}
+
+	letter := s.chooseLetter(candidates)
+	s.sequence = append(s.sequence, string(letter))
+
+	slog.Debug("Solver chose letter", domain.SecretAttr("letter", string(letter)), slog.Int("candidates", len(candidates)))
+
+	return domain.NewLetterGuess(letter), nil
+}
+
+func (s *Solver) WaitContinue() (err error) {
+	return nil
+}
+
+type SolveResult struct {
+	Category   string
+	Difficulty domain.Difficulty
+	Word       string
+	Win        bool
+	Attempts   int
+	Mistakes   int
+	// MaxMistakes is the limit of the level of the word, unless the limit is set for every word.
+	MaxMistakes int
+	Guesses     []string
+}
+
+func SolveWord(
+	word domain.Word, words []domain.Word, alphabet *domain.Alphabet, maxMistakes, wordGuessPenalty int,
+) (game *domain.Game, solver *Solver) {
+	game = domain.NewGame(&word, alphabet, maxMistakes, wordGuessPenalty)
+	solver = NewSolver(words, game)
+
+	playSolver(game, solver)
+
+	return game, solver
+}
+
+func playSolver(game domain.GameEngine, solver *Solver) {
+	for !game.IsFinished() {
+		guess, _ := solver.GetGuess()
+		game.MakeGuess(guess)
+	}
+}
+
+// SolveCollection solves the words within the maxMistakes of their levels, maxMistakes overrides them when it is set.
+func SolveCollection(categories []domain.Category, levels domain.DifficultyLevels, maxMistakes, wordGuessPenalty int) []SolveResult {
+	words := CollectionWords(categories)
+	results := make([]SolveResult, 0, len(words))
+
+	for _, category := range categories {
+		for level, levelWords := range category.WordsByLevel {
+			difficulty := domain.Difficulty(level)
+			levelMaxMistakes := levels.MaxMistakes(difficulty, maxMistakes)
+
+			for _, word := range levelWords {
+				game, solver := SolveWord(word, words, category.Alphabet, levelMaxMistakes, wordGuessPenalty)
+
+				results = append(results, SolveResult{
+					Category:    category.Name,
+					Difficulty:  difficulty,
+					Word:        game.Alphabet().FoldWord(word.Word),
+					Win:         game.IsWin(),
+					Attempts:    game.Attempts(),
+					Mistakes:    game.Mistakes(),
+					MaxMistakes: levelMaxMistakes,
+					Guesses:     solver.Sequence(),
+				})
+			}
+		}
+	}
+
+	slog.Info("Collection solved", slog.Int("words", len(results)))
+
+	return results
+}
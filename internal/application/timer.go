@@ -0,0 +1,155 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"makly/hangman/internal/domain"
+)
+
+// TimeLeftRefresh is how often the remaining time is shown while a guess is awaited.
+const TimeLeftRefresh = time.Second
+
+var (
+	errGuessTimeout = errors.New("guess time is over")
+	errGameTimeout  = errors.New("game time is over")
+)
+
+// Timing is nil or has no limits for games that are not timed.
+type Timing struct {
+	Limits domain.TimeLimits
+	Clock  domain.Clock
+}
+
+func (t *Timing) IsTimed() bool {
+	return t != nil && t.Limits.IsTimed()
+}
+
+type gameTimer struct {
+	timing       *Timing
+	gameDeadline time.Time
+	// guessDeadline is kept while invalid input is retyped, so it does not restart the guess time.
+	guessDeadline time.Time
+}
+
+func newGameTimer(timing *Timing) *gameTimer {
+	timer := &gameTimer{timing: timing}
+
+	if timing.Limits.Game > 0 {
+		timer.gameDeadline = timing.Clock.Now().Add(timing.Limits.Game)
+	}
+
+	return timer
+}
+
+// timeLeft returns zero durations for limits that are not set.
+func (t *gameTimer) timeLeft(guessDeadline time.Time) (guessLeft, gameLeft time.Duration) {
+	now := t.timing.Clock.Now()
+
+	if !guessDeadline.IsZero() {
+		guessLeft = guessDeadline.Sub(now)
+	}
+
+	if !t.gameDeadline.IsZero() {
+		gameLeft = t.gameDeadline.Sub(now)
+	}
+
+	return guessLeft, gameLeft
+}
+
+// watch shows the time left until ctx is done and cancels it with the cause of the first limit that runs out.
+func (t *gameTimer) watch(
+	ctx context.Context, cancel context.CancelCauseFunc, guessDeadline time.Time, outputer domain.GameOutputer,
+) {
+	for {
+		guessLeft, gameLeft := t.timeLeft(guessDeadline)
+
+		switch {
+		case !t.gameDeadline.IsZero() && gameLeft <= 0:
+			cancel(errGameTimeout)
+			return
+		case !guessDeadline.IsZero() && guessLeft <= 0:
+			cancel(errGuessTimeout)
+			return
+		}
+
+		outputer.ShowTimeLeft(guessLeft, gameLeft)
+
+		wait := TimeLeftRefresh
+		if guessLeft > 0 {
+			wait = min(wait, guessLeft)
+		}
+
+		if gameLeft > 0 {
+			wait = min(wait, gameLeft)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.timing.Clock.After(wait):
+		}
+	}
+}
+
+// cancelExpired cancels with the cause of the first limit that is over.
+func (t *gameTimer) cancelExpired(cancel context.CancelCauseFunc) {
+	now := t.timing.Clock.Now()
+
+	switch {
+	case !t.gameDeadline.IsZero() && !now.Before(t.gameDeadline):
+		cancel(errGameTimeout)
+	case !t.guessDeadline.IsZero() && !now.Before(t.guessDeadline):
+		cancel(errGuessTimeout)
+	}
+}
+
+// getGuess turns running out of time into a timeout guess.
+func (t *gameTimer) getGuess(inputer domain.GameInputer, outputer domain.GameOutputer) (guess *domain.Guess, err error) {
+	if t.guessDeadline.IsZero() && t.timing.Limits.Guess > 0 {
+		t.guessDeadline = t.timing.Clock.Now().Add(t.timing.Limits.Guess)
+	}
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	watched := make(chan struct{})
+
+	go func() {
+		t.watch(ctx, cancel, t.guessDeadline, outputer)
+		close(watched)
+	}()
+
+	if contextInputer, ok := inputer.(domain.ContextGameInputer); ok {
+		guess, err = contextInputer.GetGuessContext(ctx)
+	} else {
+		// An inputer that can not be interrupted is answered first, a guess given after the time is over does not count
+		guess, err = inputer.GetGuess()
+		t.cancelExpired(cancel)
+
+		if ctx.Err() != nil {
+			err = context.Cause(ctx)
+		}
+	}
+
+	cancel(nil)
+	<-watched
+
+	cause := context.Cause(ctx)
+	if err == nil || errors.Is(cause, errGuessTimeout) || errors.Is(cause, errGameTimeout) {
+		t.guessDeadline = time.Time{}
+	}
+
+	switch {
+	case err == nil:
+		return guess, nil
+	case errors.Is(cause, errGuessTimeout):
+		slog.Info("Guess time is over")
+		return domain.NewGuessTimeout(), nil
+	case errors.Is(cause, errGameTimeout):
+		slog.Info("Game time is over")
+		return domain.NewGameTimeout(), nil
+	default:
+		return nil, err
+	}
+}
@@ -0,0 +1,79 @@
+package domain
+
+import "log/slog"
+
+const (
+	// AdaptiveWindow is how many last games decide whether the level changes.
+	AdaptiveWindow = 3
+	// adaptiveMaxMistakesShare is the most of the mistakes budget the games of the window may use on average to go up.
+	adaptiveMaxMistakesShare = 0.5
+	// adaptiveMaxLosses is the most games of the window that may be lost without going down.
+	adaptiveMaxLosses = 1
+)
+
+// AdaptiveLevel follows the recent games of a player: it goes up a difficulty after a window of games won with
+// mistakes to spare and down after most games of the window are lost. The window starts anew after every change.
+type AdaptiveLevel struct {
+	difficulty Difficulty
+	hardest    Difficulty
+	// change is +1 or -1 when the last game moved the level up or down.
+	change int
+	window []RoundResult
+}
+
+// NewAdaptiveLevel starts from the middle of the levels, so there is room to move both ways.
+func NewAdaptiveLevel(levels DifficultyLevels) *AdaptiveLevel {
+	return &AdaptiveLevel{
+		difficulty: levels.Middle(),
+		hardest:    levels.Hardest(),
+		window:     make([]RoundResult, 0, AdaptiveWindow),
+	}
+}
+
+func (a *AdaptiveLevel) Difficulty() Difficulty {
+	return a.difficulty
+}
+
+// Change is +1 when the last game moved the level up, -1 when it moved the level down and 0 otherwise.
+func (a *AdaptiveLevel) Change() int {
+	return a.change
+}
+
+func (a *AdaptiveLevel) AddResult(result *RoundResult) {
+	a.window = append(a.window, *result)
+	a.window = a.window[max(0, len(a.window)-AdaptiveWindow):]
+	a.change = 0
+
+	losses, mistakesShare := 0, 0.0
+
+	for i := range a.window {
+		if !a.window[i].Win {
+			losses++
+		}
+
+		mistakesShare += float64(a.window[i].Mistakes) / float64(a.window[i].MaxMistakes) / AdaptiveWindow
+	}
+
+	switch {
+	case losses > adaptiveMaxLosses && a.difficulty > EasyDifficulty:
+		a.change = -1
+	case len(a.window) == AdaptiveWindow && losses == 0 && mistakesShare <= adaptiveMaxMistakesShare &&
+		a.difficulty < a.hardest:
+		a.change = 1
+	default:
+		return
+	}
+
+	a.difficulty += Difficulty(a.change)
+	a.window = a.window[:0]
+
+	slog.Info("Adaptive level changed", slog.Any("level", a))
+}
+
+func (a *AdaptiveLevel) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("difficulty", a.difficulty.String()),
+		slog.Int("change", a.change),
+		slog.Int("window", len(a.window)),
+	)
+}
@@ -0,0 +1,238 @@
+package domain
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// DefaultRevealed are shown from the start, so phrases like "rock 'n' roll" or "tic-tac-toe" only hide their letters.
+const DefaultRevealed = " -'’.,!?:;&0123456789"
+
+// HiddenLetter marks letters that are not guessed yet in a pattern, so it can be neither a letter nor revealed.
+const HiddenLetter = '_'
+
+type AlphabetJSON struct {
+	// Name refers to a built-in alphabet when Letters is empty.
+	Name        string            `json:"name"`
+	Letters     string            `json:"letters"`
+	Revealed    string            `json:"revealed"`
+	CaseFolding map[string]string `json:"caseFolding"`
+}
+
+func (a *AlphabetJSON) ToDomain() (alphabet *Alphabet, err error) {
+	if a == nil {
+		return LatinAlphabet, nil
+	}
+
+	if a.Letters == "" {
+		builtin, ok := AlphabetByName(a.Name)
+		if !ok {
+			return nil, &BadAlphabetError{Message: fmt.Sprintf("unknown alphabet %q", a.Name)}
+		}
+
+		if a.Revealed == "" && len(a.CaseFolding) == 0 {
+			return builtin, nil
+		}
+
+		a = &AlphabetJSON{Name: builtin.name, Letters: string(builtin.letters), Revealed: a.Revealed, CaseFolding: a.CaseFolding}
+	}
+
+	caseFolding := make(map[rune]rune, len(a.CaseFolding))
+
+	for from, to := range a.CaseFolding {
+		fromRunes, toRunes := []rune(from), []rune(to)
+		if len(fromRunes) != 1 || len(toRunes) != 1 {
+			return nil, &BadAlphabetError{Message: fmt.Sprintf("case folding %q -> %q must map a single letter", from, to)}
+		}
+
+		caseFolding[fromRunes[0]] = toRunes[0]
+	}
+
+	revealed := a.Revealed
+	if revealed == "" {
+		revealed = DefaultRevealed
+	}
+
+	return NewAlphabet(a.Name, a.Letters, revealed, caseFolding)
+}
+
+// Alphabet defines the letters that can be guessed, how input is folded to them
+// and which characters of a word are shown from the start.
+type Alphabet struct {
+	name        string
+	letters     []rune
+	isLetter    map[rune]bool
+	revealed    map[rune]bool
+	caseFolding map[rune]rune
+	// equivalents are the variant letters of normalization that apply to this alphabet.
+	normalization *Normalization
+	equivalents   map[rune]rune
+}
+
+var (
+	LatinAlphabet   = mustAlphabet("latin", "abcdefghijklmnopqrstuvwxyz")
+	RussianAlphabet = mustAlphabet("russian", "абвгдеёжзийклмнопрстуфхцчшщъыьэюя")
+	GermanAlphabet  = mustAlphabet("german", "abcdefghijklmnopqrstuvwxyzäöüß")
+)
+
+var builtinAlphabets = map[string]*Alphabet{
+	LatinAlphabet.name:   LatinAlphabet,
+	RussianAlphabet.name: RussianAlphabet,
+	GermanAlphabet.name:  GermanAlphabet,
+}
+
+func mustAlphabet(name, letters string) *Alphabet {
+	alphabet, err := NewAlphabet(name, letters, DefaultRevealed, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return alphabet
+}
+
+// AlphabetByName returns a built-in alphabet, the empty name means LatinAlphabet.
+func AlphabetByName(name string) (alphabet *Alphabet, ok bool) {
+	if name == "" {
+		return LatinAlphabet, true
+	}
+
+	alphabet, ok = builtinAlphabets[strings.ToLower(name)]
+
+	return alphabet, ok
+}
+
+// NewAlphabet takes letters as they are stored after case folding. caseFolding overrides unicode.ToLower for
+// the letters it contains, e.g. the Turkish dotted and dotless I.
+func NewAlphabet(name, letters, revealed string, caseFolding map[rune]rune) (*Alphabet, error) {
+	alphabet := &Alphabet{
+		name:        name,
+		letters:     make([]rune, 0, len(letters)),
+		isLetter:    make(map[rune]bool),
+		revealed:    make(map[rune]bool),
+		caseFolding: caseFolding,
+	}
+
+	for _, letter := range letters {
+		if alphabet.isLetter[letter] || alphabet.Fold(letter) != letter || unicode.IsSpace(letter) || letter == HiddenLetter {
+			return nil, &BadAlphabetError{Message: fmt.Sprintf("letter %q is repeated, blank, a hidden mark or not folded", letter)}
+		}
+
+		alphabet.isLetter[letter] = true
+		alphabet.letters = append(alphabet.letters, letter)
+	}
+
+	if len(alphabet.letters) == 0 {
+		return nil, &BadAlphabetError{Message: "alphabet has no letters"}
+	}
+
+	for _, char := range revealed {
+		if alphabet.isLetter[alphabet.Fold(char)] || char == HiddenLetter {
+			return nil, &BadAlphabetError{Message: fmt.Sprintf("revealed character %q is a letter or a hidden mark", char)}
+		}
+
+		alphabet.revealed[char] = true
+	}
+
+	return alphabet, nil
+}
+
+// orLatin lets games be created without an explicit alphabet.
+func orLatin(alphabet *Alphabet) *Alphabet {
+	if alphabet == nil {
+		return LatinAlphabet
+	}
+
+	return alphabet
+}
+
+func (a *Alphabet) Name() string {
+	return a.name
+}
+
+func (a *Alphabet) Letters() []rune {
+	return a.letters
+}
+
+func (a *Alphabet) Size() int {
+	return len(a.letters)
+}
+
+func (a *Alphabet) Fold(char rune) rune {
+	if folded, ok := a.caseFolding[char]; ok {
+		return folded
+	}
+
+	return unicode.ToLower(char)
+}
+
+func (a *Alphabet) FoldWord(word string) string {
+	return strings.Map(a.Fold, word)
+}
+
+// IsLetter expects an already folded character.
+func (a *Alphabet) IsLetter(char rune) bool {
+	return a.isLetter[char]
+}
+
+func (a *Alphabet) IsRevealed(char rune) bool {
+	return a.revealed[char]
+}
+
+func (a *Alphabet) ValidateWord(word string) error {
+	letters := 0
+
+	for _, char := range a.NormalizeWord(word) {
+		switch {
+		case a.isLetter[char]:
+			letters++
+		case !a.revealed[char]:
+			return &BadWordError{Message: fmt.Sprintf("character %q is not in the %s alphabet", char, a.name)}
+		}
+	}
+
+	if letters == 0 {
+		return &BadWordError{Message: "word has no letters"}
+	}
+
+	return nil
+}
+
+func (a *Alphabet) ToJSON() *AlphabetJSON {
+	caseFolding := make(map[string]string, len(a.caseFolding))
+	for from, to := range a.caseFolding {
+		caseFolding[string(from)] = string(to)
+	}
+
+	revealed := make([]rune, 0, len(a.revealed))
+	for char := range a.revealed {
+		revealed = append(revealed, char)
+	}
+
+	slices.Sort(revealed)
+
+	return &AlphabetJSON{
+		Name:        a.name,
+		Letters:     string(a.letters),
+		Revealed:    string(revealed),
+		CaseFolding: caseFolding,
+	}
+}
+
+func (a *Alphabet) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("name", a.name),
+		slog.Int("letters", len(a.letters)),
+		slog.Int("revealed", len(a.revealed)),
+	)
+}
+
+type BadAlphabetError struct {
+	Message string
+}
+
+func (e *BadAlphabetError) Error() string {
+	return fmt.Sprintf("bad alphabet: %s", e.Message)
+}
@@ -3,6 +3,8 @@ package domain
 import (
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
 )
 
 type CategoryJSON struct {
@@ -10,46 +12,126 @@ type CategoryJSON struct {
 	EasyWords   []WordJSON `json:"easy"`
 	MediumWords []WordJSON `json:"medium"`
 	HardWords   []WordJSON `json:"hard"`
+	// Levels are the words keyed by the names of the difficulty levels of the collection.
+	Levels map[string][]WordJSON `json:"levels"`
+	// Words is an unbucketed list, its words get a difficulty from the scoring model.
+	Words []WordJSON `json:"words"`
 }
 
-func (c *CategoryJSON) ToDomain() *Category {
-	easyWords := make([]Word, 0, len(c.EasyWords))
-	mediumWords := make([]Word, 0, len(c.MediumWords))
-	hardWords := make([]Word, 0, len(c.HardWords))
+// ToDomain classifies the unbucketed words by the frequencies of the letters of the collection.
+func (c *CategoryJSON) ToDomain(levels DifficultyLevels, frequencies *LetterFrequencies) (category *Category, err error) {
+	category = &Category{Name: c.Name, WordsByLevel: make([][]Word, levels.Count())}
 
-	for _, word := range c.EasyWords {
-		easyWords = append(easyWords, *word.ToDomain())
+	// The easy, medium and hard lists are the levels of the same names, they go before the levels map.
+	// Names differing only in case are merged in sorted order, so the words keep their order between loads.
+	names := []string{"easy", "medium", "hard"}
+	byName := map[string][]WordJSON{"easy": c.EasyWords, "medium": c.MediumWords, "hard": c.HardWords}
+
+	levelNames := make([]string, 0, len(c.Levels))
+	for name := range c.Levels {
+		levelNames = append(levelNames, name)
+	}
+
+	slices.Sort(levelNames)
+
+	for _, name := range levelNames {
+		if _, ok := byName[strings.ToLower(name)]; !ok {
+			names = append(names, strings.ToLower(name))
+		}
+
+		byName[strings.ToLower(name)] = append(byName[strings.ToLower(name)], c.Levels[name]...)
+	}
+
+	for _, name := range names {
+		if len(byName[name]) > 0 && levels.Parse(name) == UnknownDifficulty {
+			return nil, &BadCategoryError{Message: fmt.Sprintf("level %q is not declared by the collection", name)}
+		}
 	}
 
-	for _, word := range c.MediumWords {
-		mediumWords = append(mediumWords, *word.ToDomain())
+	for difficulty := range category.WordsByLevel {
+		for _, word := range byName[levels.Name(Difficulty(difficulty))] {
+			category.WordsByLevel[difficulty] = append(category.WordsByLevel[difficulty], *word.ToDomain())
+		}
 	}
 
-	for _, word := range c.HardWords {
-		hardWords = append(hardWords, *word.ToDomain())
+	if len(c.Words) > 0 {
+		words := make([]Word, 0, len(c.Words))
+		for _, word := range c.Words {
+			words = append(words, *word.ToDomain())
+		}
+
+		for difficulty, classified := range ClassifyWords(words, levels.Count(), frequencies) {
+			category.WordsByLevel[difficulty] = append(category.WordsByLevel[difficulty], classified...)
+		}
+	}
+
+	return category, nil
+}
+
+// words are all the words of the category in every list.
+func (c *CategoryJSON) words() []string {
+	words := make([]string, 0)
+
+	for _, list := range [][]WordJSON{c.EasyWords, c.MediumWords, c.HardWords, c.Words} {
+		for _, word := range list {
+			words = append(words, word.Word)
+		}
 	}
 
-	return &Category{
-		Name:        c.Name,
-		EasyWords:   easyWords,
-		MediumWords: mediumWords,
-		HardWords:   hardWords,
+	for _, list := range c.Levels {
+		for _, word := range list {
+			words = append(words, word.Word)
+		}
 	}
+
+	return words
 }
 
 type Category struct {
-	Name        string
-	EasyWords   []Word
-	MediumWords []Word
-	HardWords   []Word
+	Name string
+	// Alphabet is shared by all categories of a collection, nil means LatinAlphabet.
+	Alphabet *Alphabet
+	// WordsByLevel are indexed by the difficulty.
+	WordsByLevel [][]Word
+}
+
+// WordsByDifficulty returns nil for a difficulty that is not a level of the category.
+func (c *Category) WordsByDifficulty(difficulty Difficulty) []Word {
+	if difficulty < 0 || int(difficulty) >= len(c.WordsByLevel) {
+		return nil
+	}
+
+	return c.WordsByLevel[difficulty]
+}
+
+// WordsCount counts the words of all levels.
+func (c *Category) WordsCount() int {
+	count := 0
+	for _, words := range c.WordsByLevel {
+		count += len(words)
+	}
+
+	return count
+}
+
+// Validate names the words by the levels the category is built with.
+func (c *Category) Validate(levels DifficultyLevels) error {
+	for difficulty, words := range c.WordsByLevel {
+		for _, word := range words {
+			if err := word.Validate(c.Alphabet); err != nil {
+				return fmt.Errorf("validate %s word: %w", levels.Name(Difficulty(difficulty)), err)
+			}
+		}
+	}
+
+	return nil
 }
 
 func (c *Category) LogValue() slog.Value {
 	return slog.GroupValue(
 		slog.String("name", c.Name),
-		slog.Int("easy words count", len(c.EasyWords)),
-		slog.Int("medium words count", len(c.MediumWords)),
-		slog.Int("hard words count", len(c.HardWords)),
+		slog.Int("levels", len(c.WordsByLevel)),
+		slog.Int("words count", c.WordsCount()),
 	)
 }
 
@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// Clock lets timed games be tested without waiting for the real time.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// TimeLimits of zero duration are not limited.
+type TimeLimits struct {
+	Guess time.Duration
+	Game  time.Duration
+}
+
+func (t TimeLimits) IsTimed() bool {
+	return t.Guess > 0 || t.Game > 0
+}
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// DailyGridWidth is how many guesses a row of the shared grid has.
+const DailyGridWidth = 10
+
+type DailyResultJSON struct {
+	Date       string `json:"date"`
+	Collection string `json:"collection"`
+	Finished   bool   `json:"finished"`
+	Won        bool   `json:"won"`
+	Summary    string `json:"summary,omitempty"`
+}
+
+func (d *DailyResultJSON) ToDomain() *DailyResult {
+	return &DailyResult{
+		Date:       d.Date,
+		Collection: d.Collection,
+		Finished:   d.Finished,
+		Won:        d.Won,
+		Summary:    d.Summary,
+	}
+}
+
+// DailyResult is kept from the start of the daily challenge, so a player who quits does not get another try.
+type DailyResult struct {
+	// Date is the local date of the challenge in the time.DateOnly layout.
+	Date string
+	// Collection is the content hash of the words collection the challenge is played from.
+	Collection string
+	Finished   bool
+	Won        bool
+	// Summary is the spoiler-free result to share, it is set when the challenge is finished.
+	Summary string
+}
+
+func NewDailyResult(date, collection string, game *Game) *DailyResult {
+	result := &DailyResult{Date: date, Collection: collection, Finished: game.IsFinished(), Won: game.IsWin()}
+
+	if result.Finished {
+		result.Summary = DailySummary(date, game)
+	}
+
+	return result
+}
+
+func (d *DailyResult) ToJSON() *DailyResultJSON {
+	return &DailyResultJSON{
+		Date:       d.Date,
+		Collection: d.Collection,
+		Finished:   d.Finished,
+		Won:        d.Won,
+		Summary:    d.Summary,
+	}
+}
+
+func (d *DailyResult) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("date", d.Date),
+		slog.String("collection", d.Collection),
+		slog.Bool("finished", d.Finished),
+		slog.Bool("won", d.Won),
+	)
+}
+
+// DailySummary shows the guesses of the game as a grid of hits and misses, so it does not give the word away:
+//
+//	Hangman 2026-10-18 2/6
+//	🟥🟩🟩💡🟥✅
+func DailySummary(date string, game *Game) string {
+	score := "X"
+	if game.IsWin() {
+		score = fmt.Sprint(game.mistakes)
+	}
+
+	var grid strings.Builder
+
+	cells := 0
+
+	for i := range game.events {
+		cell := dailyCell(&game.events[i])
+		if cell == "" {
+			continue
+		}
+
+		if cells > 0 && cells%DailyGridWidth == 0 {
+			grid.WriteString("\n")
+		}
+
+		grid.WriteString(cell)
+
+		cells++
+	}
+
+	return fmt.Sprintf("Hangman %s %s/%d\n%s", date, score, game.maxMistakes, grid.String())
+}
+
+func dailyCell(event *Event) string {
+	switch {
+	case event.Kind == LetterGuessed && event.Hit:
+		return "🟩"
+	case event.Kind == LetterGuessed:
+		return "🟥"
+	case event.Kind == WordGuessed && event.Hit:
+		return "✅"
+	case event.Kind == WordGuessed:
+		return "❌"
+	case event.Kind == HintRequested:
+		return "💡"
+	case event.Kind == LetterBought:
+		return "🛒"
+	case event.Kind == GuessTimedOut:
+		return "⏰"
+	default:
+		return ""
+	}
+}
@@ -1,37 +1,240 @@
 package domain
 
-import "fmt"
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
 
+// Difficulty is the index of a level in the difficulty levels of the collection being played, easier levels go first.
 type Difficulty int
 
+// The default levels are used by collections that do not declare their own.
 const (
 	EasyDifficulty Difficulty = iota
 	MediumDifficulty
 	HardDifficulty
-	UnknownDifficulty
 )
 
-const DifficultyCount = 3
+const (
+	UnknownDifficulty Difficulty = -1 - iota
+	// AdaptiveDifficulty is not a level of words, the level of every game follows the recent games of the player.
+	AdaptiveDifficulty
+)
+
+// levelNamePattern keeps level names usable as flag values and JSON keys.
+var levelNamePattern = regexp.MustCompile(`^[\p{Ll}\p{N}_-]+$`)
+
+// DefaultDifficultyLevels leave maxMistakes and hint policies to the settings of the game.
+var DefaultDifficultyLevels = DifficultyLevels{{Name: "easy"}, {Name: "medium"}, {Name: "hard"}}
 
+// String does not know the names of the levels, menus and reports name difficulties by DifficultyLevels.Title.
 func (d Difficulty) String() string {
-	return [...]string{"Easy", "Medium", "Hard", "Unknown"}[d]
+	switch {
+	case d == AdaptiveDifficulty:
+		return "Adaptive"
+	case d < 0:
+		return "Unknown"
+	}
+
+	return fmt.Sprintf("Level %d", int(d)+1)
+}
+
+type DifficultyLevelJSON struct {
+	Name        string `json:"name"`
+	MaxMistakes int    `json:"maxMistakes,omitempty"`
+	HintPolicy  string `json:"hintPolicy,omitempty"`
+}
+
+func (d *DifficultyLevelJSON) ToDomain() (level *DifficultyLevel, err error) {
+	level = &DifficultyLevel{Name: strings.ToLower(d.Name), MaxMistakes: d.MaxMistakes}
+
+	if d.HintPolicy != "" {
+		level.HintPolicy, err = ParseHintPolicy(d.HintPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("parse hint policy of level %q: %w", d.Name, err)
+		}
+	}
+
+	return level, nil
+}
+
+type DifficultyLevel struct {
+	// Name is in lower case.
+	Name string
+	// MaxMistakes is zero when the game settings decide.
+	MaxMistakes int
+	// HintPolicy is nil when the configured policies decide.
+	HintPolicy HintPolicy
 }
 
-func (d *Difficulty) Set(value string) error {
-	switch value {
-	case "easy":
-		*d = EasyDifficulty
-	case "medium":
-		*d = MediumDifficulty
-	case "hard":
-		*d = HardDifficulty
-	default:
-		*d = UnknownDifficulty
+func (d *DifficultyLevel) ToJSON() DifficultyLevelJSON {
+	level := DifficultyLevelJSON{Name: d.Name, MaxMistakes: d.MaxMistakes}
+	if d.HintPolicy != nil {
+		level.HintPolicy = d.HintPolicy.String()
+	}
+
+	return level
+}
+
+// DifficultyLevels are the levels of a collection, easier levels go first.
+// Empty levels are used as DefaultDifficultyLevels, so settings without levels play the default ones.
+type DifficultyLevels []DifficultyLevel
+
+// NewDifficultyLevels returns DefaultDifficultyLevels when there are no levels.
+func NewDifficultyLevels(levelsJSON []DifficultyLevelJSON) (levels DifficultyLevels, err error) {
+	if len(levelsJSON) == 0 {
+		return DefaultDifficultyLevels, nil
+	}
+
+	levels = make(DifficultyLevels, 0, len(levelsJSON))
+
+	for i := range levelsJSON {
+		level, err := levelsJSON[i].ToDomain()
+		if err != nil {
+			return nil, err
+		}
+
+		levels = append(levels, *level)
+	}
+
+	return levels, nil
+}
+
+func (d DifficultyLevels) Count() int {
+	return len(d.orDefault())
+}
+
+func (d DifficultyLevels) orDefault() DifficultyLevels {
+	if len(d) == 0 {
+		return DefaultDifficultyLevels
+	}
+
+	return d
+}
+
+// Name returns the name of the level of the difficulty, "adaptive" or "unknown".
+func (d DifficultyLevels) Name(difficulty Difficulty) string {
+	levels := d.orDefault()
+
+	switch {
+	case difficulty == AdaptiveDifficulty:
+		return "adaptive"
+	case difficulty < 0 || int(difficulty) >= levels.Count():
+		return "unknown"
+	}
+
+	return levels[difficulty].Name
+}
+
+// Title is the Name starting with a capital letter for menus and reports.
+func (d DifficultyLevels) Title(difficulty Difficulty) string {
+	name := d.Name(difficulty)
+	first, size := utf8.DecodeRuneInString(name)
+
+	return string(unicode.ToUpper(first)) + name[size:]
+}
+
+// MaxMistakes returns maxMistakes when it is set for the game, the maxMistakes of the level otherwise.
+func (d DifficultyLevels) MaxMistakes(difficulty Difficulty, maxMistakes int) int {
+	if maxMistakes > 0 {
+		return maxMistakes
+	}
+
+	levels := d.orDefault()
+	if difficulty >= 0 && int(difficulty) < levels.Count() && levels[difficulty].MaxMistakes > 0 {
+		return levels[difficulty].MaxMistakes
+	}
+
+	return StateCount
+}
+
+// Parse returns UnknownDifficulty for a name that is not a level.
+func (d DifficultyLevels) Parse(name string) Difficulty {
+	levels := d.orDefault()
+
+	for i := range levels {
+		if levels[i].Name == strings.ToLower(name) {
+			return Difficulty(i)
+		}
+	}
+
+	return UnknownDifficulty
+}
+
+func (d DifficultyLevels) Middle() Difficulty {
+	return Difficulty((d.Count() - 1) / 2)
+}
+
+func (d DifficultyLevels) Hardest() Difficulty {
+	return Difficulty(d.Count() - 1)
+}
+
+// IsDefault is true for the levels that are not declared by the collection.
+func (d DifficultyLevels) IsDefault() bool {
+	levels := d.orDefault()
+	if len(levels) != len(DefaultDifficultyLevels) {
+		return false
+	}
+
+	for i := range levels {
+		if levels[i].Name != DefaultDifficultyLevels[i].Name || levels[i].MaxMistakes != 0 || levels[i].HintPolicy != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Validate uses LatinAlphabet when alphabet is nil.
+func (d DifficultyLevels) Validate(alphabet *Alphabet) error {
+	if len(d) == 0 {
+		return &BadDifficultyError{Message: "there are no levels"}
+	}
+
+	names := make(map[string]bool, len(d))
+
+	for i := range d {
+		level := &d[i]
+
+		switch {
+		case !levelNamePattern.MatchString(level.Name) || level.Name == "unknown" || level.Name == "adaptive":
+			return &BadDifficultyError{Message: fmt.Sprintf("level name %q must be letters, digits, '-' or '_'", level.Name)}
+		case names[level.Name]:
+			return &BadDifficultyError{Message: fmt.Sprintf("level %q is declared twice", level.Name)}
+		case level.MaxMistakes < 0 || level.MaxMistakes > orLatin(alphabet).Size():
+			return &BadDifficultyError{
+				Message: fmt.Sprintf("maxMistakes of level %q must be from 1 to the alphabet size when set", level.Name),
+			}
+		}
+
+		names[level.Name] = true
 	}
 
 	return nil
 }
 
+func (d DifficultyLevels) ToJSON() []DifficultyLevelJSON {
+	levels := make([]DifficultyLevelJSON, 0, len(d))
+	for i := range d {
+		levels = append(levels, d[i].ToJSON())
+	}
+
+	return levels
+}
+
+func (d DifficultyLevels) LogValue() slog.Value {
+	names := make([]string, 0, len(d))
+	for i := range d {
+		names = append(names, d[i].Name)
+	}
+
+	return slog.StringValue(strings.Join(names, ", "))
+}
+
 type BadDifficultyError struct {
 	Message string
 }
@@ -1 +1,1819 @@
 package domain_test
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"log/slog"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"makly/hangman/internal/domain"
+)
+
+func TestRoundScore(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	tests := []struct {
+		name        string
+		word        string
+		maxMistakes int
+		guesses     []rune
+		difficulty  domain.Difficulty
+		expected    int
+	}{
+		{
+			name:        "Easy win without mistakes",
+			word:        "cat",
+			maxMistakes: 6,
+			guesses:     []rune{'c', 'a', 't'},
+			difficulty:  domain.EasyDifficulty,
+			expected:    3*domain.LetterScore + domain.RemainingMistakesMax,
+		},
+		{
+			name:        "Hard win with half of mistakes",
+			word:        "cat",
+			maxMistakes: 6,
+			guesses:     []rune{'x', 'y', 'z', 'c', 'a', 't'},
+			difficulty:  domain.HardDifficulty,
+			expected:    (3*domain.LetterScore + domain.RemainingMistakesMax/2) * 3,
+		},
+		{
+			name:        "Spaces are not counted as letters",
+			word:        "ab cd",
+			maxMistakes: 4,
+			guesses:     []rune{'a', 'b', 'c', 'd'},
+			difficulty:  domain.MediumDifficulty,
+			expected:    (4*domain.LetterScore + domain.RemainingMistakesMax) * 2,
+		},
+		{
+			name:        "Lost round",
+			word:        "cat",
+			maxMistakes: 2,
+			guesses:     []rune{'x', 'y'},
+			difficulty:  domain.HardDifficulty,
+			expected:    0,
+		},
+	}
+
+	assertInstance := assert.New(t)
+
+	for _, tt := range tests {
+		game := domain.NewGame(&domain.Word{Word: tt.word}, domain.LatinAlphabet, tt.maxMistakes, domain.DefaultWordGuessPenalty)
+		for _, guess := range tt.guesses {
+			game.Guess(guess)
+		}
+
+		assertInstance.Equal(tt.expected, domain.RoundScore(game, tt.difficulty), tt.name)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+	match := domain.NewMatch(2, domain.DefaultDifficultyLevels)
+
+	assertInstance.Equal(1, match.CurrentRound())
+	assertInstance.False(match.IsFinished())
+
+	won := domain.NewGame(&domain.Word{Word: "cat"}, domain.LatinAlphabet, 6, domain.DefaultWordGuessPenalty)
+	won.GuessWord("cat")
+	match.AddResult(domain.NewRoundResult(match.CurrentRound(), "Animals", domain.EasyDifficulty, won))
+
+	assertInstance.Equal(2, match.CurrentRound())
+	assertInstance.False(match.IsFinished())
+
+	lost := domain.NewGame(&domain.Word{Word: "dog"}, domain.LatinAlphabet, 1, domain.DefaultWordGuessPenalty)
+	lost.Guess('x')
+	match.AddResult(domain.NewRoundResult(match.CurrentRound(), "Animals", domain.HardDifficulty, lost))
+
+	assertInstance.True(match.IsFinished())
+	assertInstance.Equal(1, match.Wins())
+	assertInstance.Equal(3*domain.LetterScore+domain.RemainingMistakesMax, match.TotalScore())
+	assertInstance.Len(match.Results(), 2)
+	assertInstance.Equal("dog", match.Results()[1].Word)
+	assertInstance.False(match.Results()[1].Win)
+}
+
+func TestAdaptiveLevel(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	won := domain.RoundResult{Win: true, Mistakes: 1, MaxMistakes: 6}
+	tight := domain.RoundResult{Win: true, Mistakes: 5, MaxMistakes: 6}
+	lost := domain.RoundResult{Win: false, Mistakes: 6, MaxMistakes: 6}
+	fiveLevels := domain.DifficultyLevels{{Name: "beginner"}, {Name: "easy"}, {Name: "medium"}, {Name: "hard"}, {Name: "expert"}}
+
+	tests := []struct {
+		name     string
+		levels   domain.DifficultyLevels
+		results  []domain.RoundResult
+		expected domain.Difficulty
+		change   int
+	}{
+		{
+			name:     "Window is not full",
+			levels:   domain.DefaultDifficultyLevels,
+			results:  []domain.RoundResult{won, won},
+			expected: domain.MediumDifficulty,
+		},
+		{
+			name:     "Window is won",
+			levels:   domain.DefaultDifficultyLevels,
+			results:  []domain.RoundResult{won, won, won},
+			expected: domain.HardDifficulty,
+			change:   1,
+		},
+		{
+			name:     "Window is won with too many mistakes",
+			levels:   domain.DefaultDifficultyLevels,
+			results:  []domain.RoundResult{won, tight, tight},
+			expected: domain.MediumDifficulty,
+		},
+		{
+			name:     "Hardest level",
+			levels:   domain.DefaultDifficultyLevels,
+			results:  []domain.RoundResult{won, won, won, won, won, won},
+			expected: domain.HardDifficulty,
+		},
+		{
+			name:     "Most games are lost",
+			levels:   domain.DefaultDifficultyLevels,
+			results:  []domain.RoundResult{lost, won, lost},
+			expected: domain.EasyDifficulty,
+			change:   -1,
+		},
+		{
+			name:     "Easiest level",
+			levels:   domain.DefaultDifficultyLevels,
+			results:  []domain.RoundResult{lost, lost, lost, lost},
+			expected: domain.EasyDifficulty,
+		},
+		{
+			name:     "Window starts anew",
+			levels:   domain.DefaultDifficultyLevels,
+			results:  []domain.RoundResult{lost, lost, won, won, won, won, won},
+			expected: domain.MediumDifficulty,
+		},
+		{
+			name:     "Rolling window",
+			levels:   domain.DefaultDifficultyLevels,
+			results:  []domain.RoundResult{lost, won, won, won},
+			expected: domain.HardDifficulty,
+			change:   1,
+		},
+		{
+			name:     "Levels of the collection",
+			levels:   fiveLevels,
+			results:  []domain.RoundResult{won, won, won, won, won, won},
+			expected: fiveLevels.Hardest(),
+			change:   1,
+		},
+	}
+
+	assertInstance := assert.New(t)
+
+	for _, tt := range tests {
+		level := domain.NewAdaptiveLevel(tt.levels)
+		for i := range tt.results {
+			level.AddResult(&tt.results[i])
+		}
+
+		assertInstance.Equal(tt.expected, level.Difficulty(), tt.name)
+		assertInstance.Equal(tt.change, level.Change(), tt.name)
+	}
+}
+
+func TestNewHotSeatGame(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	tests := []struct {
+		name        string
+		players     []string
+		expectError bool
+	}{
+		{
+			name:        "Two players",
+			players:     []string{"Alice", "Bob"},
+			expectError: false,
+		},
+		{
+			name:        "Eight players",
+			players:     []string{"A", "B", "C", "D", "E", "F", "G", "H"},
+			expectError: false,
+		},
+		{
+			name:        "Single player",
+			players:     []string{"Alice"},
+			expectError: true,
+		},
+		{
+			name:        "Nine players",
+			players:     []string{"A", "B", "C", "D", "E", "F", "G", "H", "I"},
+			expectError: true,
+		},
+		{
+			name:        "Repeated names",
+			players:     []string{"Alice", "Alice"},
+			expectError: true,
+		},
+		{
+			name:        "Empty name",
+			players:     []string{"Alice", " "},
+			expectError: true,
+		},
+	}
+
+	var playersErr *domain.BadPlayersError
+
+	assertInstance := assert.New(t)
+
+	for _, tt := range tests {
+		_, err := domain.NewHotSeatGame(
+			&domain.Word{Word: "test"}, domain.LatinAlphabet, 6, domain.DefaultWordGuessPenalty, tt.players, domain.SharedMistakes,
+		)
+
+		if tt.expectError {
+			assertInstance.ErrorAs(err, &playersErr, tt.name)
+		} else {
+			assertInstance.NoError(err, tt.name)
+		}
+	}
+}
+
+func TestHotSeatSharedMistakes(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	hotSeat, err := domain.NewHotSeatGame(
+		&domain.Word{Word: "abc"}, domain.LatinAlphabet, 2, domain.DefaultWordGuessPenalty, []string{"Alice", "Bob"}, domain.SharedMistakes,
+	)
+	assertInstance.NoError(err)
+
+	assertInstance.Equal("Alice", hotSeat.CurrentPlayer().Name())
+
+	hotSeat.Guess('x')
+	assertInstance.Equal("Bob", hotSeat.CurrentPlayer().Name())
+
+	// Used letter does not pass the turn
+	hotSeat.Guess('x')
+	assertInstance.Equal("Bob", hotSeat.CurrentPlayer().Name())
+
+	hotSeat.Guess('a')
+	assertInstance.Equal("Alice", hotSeat.CurrentPlayer().Name())
+
+	hotSeat.Guess('y')
+	assertInstance.True(hotSeat.IsFinished())
+	assertInstance.Nil(hotSeat.Winner())
+	assertInstance.Equal(2, hotSeat.Players()[0].Mistakes())
+	assertInstance.Equal(0, hotSeat.Players()[1].Mistakes())
+}
+
+func TestHotSeatPersonalMistakes(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	hotSeat, err := domain.NewHotSeatGame(
+		&domain.Word{Word: "abc"}, domain.LatinAlphabet, 1, domain.DefaultWordGuessPenalty,
+		[]string{"Alice", "Bob", "Carol"}, domain.PersonalMistakes,
+	)
+	assertInstance.NoError(err)
+
+	// Alice uses her single mistake and is eliminated
+	hotSeat.Guess('x')
+	assertInstance.True(hotSeat.Players()[0].IsEliminated())
+	assertInstance.False(hotSeat.IsFinished())
+	assertInstance.Equal("Bob", hotSeat.CurrentPlayer().Name())
+
+	hotSeat.Guess('a')
+	assertInstance.Equal("Carol", hotSeat.CurrentPlayer().Name())
+
+	hotSeat.Guess('b')
+
+	// Eliminated Alice is skipped
+	assertInstance.Equal("Bob", hotSeat.CurrentPlayer().Name())
+
+	hotSeat.Guess('c')
+	assertInstance.True(hotSeat.IsFinished())
+	assertInstance.Equal("Bob", hotSeat.Winner().Name())
+	assertInstance.Equal(2, hotSeat.Players()[1].Attempts())
+}
+
+func TestHotSeatAllPlayersEliminated(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	hotSeat, err := domain.NewHotSeatGame(
+		&domain.Word{Word: "abc"}, domain.LatinAlphabet, 1, domain.DefaultWordGuessPenalty,
+		[]string{"Alice", "Bob"}, domain.PersonalMistakes,
+	)
+	assertInstance.NoError(err)
+
+	hotSeat.Guess('x')
+	assertInstance.False(hotSeat.IsFinished())
+
+	hotSeat.Guess('y')
+	assertInstance.True(hotSeat.IsFinished())
+	assertInstance.True(hotSeat.Game().IsLose())
+	assertInstance.Nil(hotSeat.Winner())
+}
+
+func TestHotSeatLargeGroupEliminated(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	players := []string{"Alice", "Bob", "Carol", "Dave", "Eve", "Frank", "Grace", "Heidi"}
+
+	hotSeat, err := domain.NewHotSeatGame(
+		&domain.Word{Word: "ab"}, domain.LatinAlphabet, 6, domain.DefaultWordGuessPenalty, players, domain.PersonalMistakes,
+	)
+	assertInstance.NoError(err)
+
+	// 24 wrong letters are shared by 8 players
+	assertInstance.Equal(3, hotSeat.PlayerMaxMistakes())
+
+	for letter := 'c'; letter <= 'z'; letter++ {
+		assertInstance.False(hotSeat.IsFinished())
+
+		hotSeat.Guess(letter)
+	}
+
+	assertInstance.True(hotSeat.IsFinished())
+	assertInstance.True(hotSeat.Game().IsLose())
+	assertInstance.Nil(hotSeat.Winner())
+
+	for _, player := range hotSeat.Players() {
+		assertInstance.True(player.IsEliminated())
+	}
+}
+
+func TestHotSeatMakeGuess(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	hotSeat, err := domain.NewHotSeatGame(
+		&domain.Word{Word: "abc", Hint: "first letters"}, domain.LatinAlphabet, 2, 2,
+		[]string{"Alice", "Bob"}, domain.PersonalMistakes,
+	)
+	assertInstance.NoError(err)
+
+	hotSeat.Game().SetHintPolicy(domain.OnRequestHints{Cost: 1})
+
+	// A hint costs a mistake but keeps the turn
+	hotSeat.MakeGuess(domain.NewHintRequest())
+	assertInstance.Equal("Alice", hotSeat.CurrentPlayer().Name())
+	assertInstance.Equal(1, hotSeat.Players()[0].Mistakes())
+
+	// The penalty of a wrong word is not taken beyond the personal budget
+	hotSeat.MakeGuess(domain.NewWordGuess("abd"))
+	assertInstance.True(hotSeat.Players()[0].IsEliminated())
+	assertInstance.Equal(2, hotSeat.Players()[0].Mistakes())
+	assertInstance.Equal(2, hotSeat.Game().Mistakes())
+	assertInstance.False(hotSeat.IsFinished())
+
+	hotSeat.MakeGuess(domain.NewUndoRequest())
+	assertInstance.Equal("Bob", hotSeat.CurrentPlayer().Name())
+	assertInstance.True(hotSeat.Players()[0].IsEliminated())
+
+	hotSeat.MakeGuess(domain.NewWordGuess("abc"))
+	assertInstance.True(hotSeat.IsFinished())
+	assertInstance.Equal("Bob", hotSeat.Winner().Name())
+}
+
+func TestMistakesRuleSet(t *testing.T) {
+	assertInstance := assert.New(t)
+
+	var rule domain.MistakesRule
+
+	assertInstance.NoError(rule.Set("personal"))
+	assertInstance.Equal(domain.PersonalMistakes, rule)
+
+	assertInstance.NoError(rule.Set("shared"))
+	assertInstance.Equal(domain.SharedMistakes, rule)
+
+	assertInstance.Error(rule.Set("unknown"))
+}
+
+func TestWordValidate(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	tests := []struct {
+		name        string
+		word        string
+		expectError bool
+	}{
+		{name: "Single word", word: "apple", expectError: false},
+		{name: "Upper case phrase", word: "Hello World", expectError: false},
+		{name: "Empty word", word: "", expectError: true},
+		{name: "Only spaces", word: "   ", expectError: true},
+		{name: "Revealed characters", word: "rock 'n' roll 2", expectError: false},
+		{name: "Hidden mark", word: "snake_case", expectError: true},
+		{name: "Only revealed characters", word: "1-2", expectError: true},
+		{name: "Non-latin letters", word: "яблоко", expectError: true},
+	}
+
+	var wordErr *domain.BadWordError
+
+	assertInstance := assert.New(t)
+
+	for _, tt := range tests {
+		err := (&domain.Word{Word: tt.word}).Validate(domain.LatinAlphabet)
+
+		if tt.expectError {
+			assertInstance.ErrorAs(err, &wordErr, tt.name)
+		} else {
+			assertInstance.NoError(err, tt.name)
+		}
+	}
+}
+
+func TestWordMetadata(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+	wordJSON := domain.WordJSON{Word: "cat", Weight: 2, Tags: []string{"Mammal", "pet"}, Source: "Zoo", AddedOn: "2026-10-18"}
+
+	word := wordJSON.ToDomain()
+	assertInstance.Equal(
+		&domain.Word{Word: "cat", Weight: 2, Tags: []string{"mammal", "pet"}, Source: "Zoo", AddedOn: "2026-10-18"}, word,
+	)
+	assertInstance.True(word.HasTag("pet"))
+	assertInstance.False(word.HasTag("Mammal"))
+	assertInstance.NoError(word.Validate(domain.LatinAlphabet))
+
+	// Words without a weight have the default one
+	assertInstance.InDelta(domain.DefaultWordWeight, (&domain.Word{Word: "cat"}).SelectionWeight(), 0)
+
+	tests := []struct {
+		name string
+		word domain.Word
+	}{
+		{name: "Negative weight", word: domain.Word{Word: "cat", Weight: -1}},
+		{name: "Bad tag", word: domain.Word{Word: "cat", Tags: []string{"big cat"}}},
+		{name: "Bad date", word: domain.Word{Word: "cat", AddedOn: "2026-13-01"}},
+	}
+
+	var wordErr *domain.BadWordError
+
+	for _, tt := range tests {
+		assertInstance.ErrorAs(tt.word.Validate(domain.LatinAlphabet), &wordErr, tt.name)
+	}
+}
+
+func TestParseTagFilter(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	tests := []struct {
+		value       string
+		expected    domain.TagFilter
+		expectError bool
+	}{
+		{value: "", expected: domain.TagFilter{}},
+		{value: "animals+Mammal", expected: domain.TagFilter{Required: []string{"animals", "mammal"}}},
+		{value: "!obscure", expected: domain.TagFilter{Excluded: []string{"obscure"}}},
+		{value: "mammal + !obscure", expected: domain.TagFilter{Required: []string{"mammal"}, Excluded: []string{"obscure"}}},
+		{value: "mammal+", expectError: true},
+		{value: "!!obscure", expectError: true},
+	}
+
+	assertInstance := assert.New(t)
+
+	for _, tt := range tests {
+		filter, err := domain.ParseTagFilter(tt.value)
+
+		if tt.expectError {
+			assertInstance.Error(err, tt.value)
+			continue
+		}
+
+		assertInstance.NoError(err, tt.value)
+		assertInstance.Equal(tt.expected, filter, tt.value)
+
+		parsed, err := domain.ParseTagFilter(filter.String())
+		assertInstance.NoError(err, tt.value)
+		assertInstance.Equal(filter, parsed, tt.value)
+	}
+}
+
+func TestTagFilter(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	words := []domain.Word{
+		{Word: "cat", Tags: []string{"animals", "mammal"}},
+		{Word: "okapi", Tags: []string{"animals", "mammal", "obscure"}},
+		{Word: "ant", Tags: []string{"animals"}},
+		{Word: "fig"},
+	}
+
+	filter := func(value string) (filtered []string) {
+		tagFilter, err := domain.ParseTagFilter(value)
+		assert.NoError(t, err)
+
+		for _, word := range tagFilter.Filter(words) {
+			filtered = append(filtered, word.Word)
+		}
+
+		return filtered
+	}
+
+	assert.Equal(t, []string{"cat", "okapi", "ant", "fig"}, filter(""))
+	assert.Equal(t, []string{"cat", "okapi"}, filter("animals+mammal"))
+	assert.Equal(t, []string{"cat", "ant", "fig"}, filter("!obscure"))
+	assert.Equal(t, []string{"cat"}, filter("mammal+!obscure"))
+	assert.Empty(t, filter("bird"))
+}
+
+func TestChooseWeighted(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	const iterations = 10000
+
+	words := []domain.Word{{Word: "common", Weight: 3}, {Word: "usual"}, {Word: "rare", Weight: 0.01}}
+	random := rand.New(rand.NewPCG(1, 2))
+	counts := make(map[string]int)
+
+	for range iterations {
+		counts[domain.ChooseWeighted(words, random).Word]++
+	}
+
+	// The weights are 3, 1 and 0.01 of 4.01
+	assert.InDelta(t, 0.75, float64(counts["common"])/iterations, 0.03)
+	assert.InDelta(t, 0.25, float64(counts["usual"])/iterations, 0.03)
+	assert.Less(t, counts["rare"], iterations/100)
+}
+
+func TestEvilGameDodgesGuesses(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	pool := []domain.Word{
+		{Word: "cat"}, {Word: "dog"}, {Word: "cow"}, {Word: "pig"}, {Word: "goose"}, {Word: "ox en"},
+	}
+
+	game := domain.NewEvilGame(&domain.Word{Word: "Cat"}, pool, domain.LatinAlphabet, 6, domain.DefaultWordGuessPenalty)
+
+	// Only words of the same shape remain
+	assertInstance.Len(game.Candidates(), 4)
+	assertInstance.Equal("___", game.Pattern())
+
+	// Every letter of "cat" leaves a larger family without it
+	game.Guess('a')
+	assertInstance.Equal(1, game.Mistakes())
+	assertInstance.Equal("___", game.Pattern())
+	assertInstance.Len(game.Candidates(), 3)
+
+	// "o" is in the middle of "dog" and "cow", the miss family "pig" is smaller
+	game.Guess('o')
+	assertInstance.Equal(1, game.Mistakes())
+	assertInstance.Equal("_o_", game.Pattern())
+	assertInstance.Len(game.Candidates(), 2)
+
+	// The engine commits to a single word only when forced
+	game.Guess('g')
+	assertInstance.Equal(2, game.Mistakes())
+	assertInstance.Equal([]domain.Word{{Word: "cow"}}, game.Candidates())
+
+	game.GuessWord("cow")
+	assertInstance.True(game.IsWin())
+	assertInstance.Equal("cow", game.Pattern())
+}
+
+func TestEvilGameWordGuess(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	pool := []domain.Word{{Word: "cat"}, {Word: "dog"}}
+	game := domain.NewEvilGame(&domain.Word{Word: "cat"}, pool, domain.LatinAlphabet, 6, domain.DefaultWordGuessPenalty)
+
+	// A correct word is dropped while another candidate remains
+	game.GuessWord("cat")
+	assertInstance.False(game.IsWin())
+	assertInstance.Equal(domain.DefaultWordGuessPenalty, game.Mistakes())
+	assertInstance.Equal([]domain.Word{{Word: "dog"}}, game.Candidates())
+
+	// Spaces around the guess are not a part of the word
+	game.GuessWord(" dog ")
+	assertInstance.True(game.IsWin())
+	assertInstance.True(game.IsFinished())
+	assertInstance.Equal("dog", game.Pattern())
+}
+
+func TestEvilGameKeepsSpelling(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	latin := domain.LatinAlphabet.WithNormalization(domain.DiacriticsNormalization)
+	pool := []domain.Word{{Word: "Café"}, {Word: "bake"}}
+	game := domain.NewEvilGame(&domain.Word{Word: "cafe"}, pool, latin, 6, domain.DefaultWordGuessPenalty)
+
+	// "e" is in both candidates, so it is revealed as the first candidate spells it
+	game.Guess('e')
+	assertInstance.Equal("___é", game.Pattern())
+
+	game.Guess('b')
+	assertInstance.Equal([]domain.Word{{Word: "café"}}, game.Candidates())
+
+	game.GuessWord("cafe")
+	assertInstance.True(game.IsWin())
+	assertInstance.Equal("café", game.Pattern())
+}
+
+func TestEvilGameLose(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	game := domain.NewEvilGame(
+		&domain.Word{Word: "hello world", Hint: "A greeting"}, nil, domain.LatinAlphabet, 2, domain.DefaultWordGuessPenalty,
+	)
+
+	assertInstance.Equal("_____ _____", game.Pattern())
+	assertInstance.Empty(game.Hints())
+
+	game.Guess('x')
+	assertInstance.Equal([]string{"A greeting"}, game.Hints())
+
+	game.Guess('z')
+	assertInstance.True(game.IsLose())
+	assertInstance.Equal(domain.RightLeg, game.State())
+}
+
+func TestWordDifficultyScore(t *testing.T) {
+	tests := []struct {
+		name   string
+		easier string
+		harder string
+	}{
+		{name: "length", easier: "tea", harder: "teas"},
+		{name: "distinct letters", easier: "tatata", harder: "tarots"},
+		{name: "letter rarity", easier: "tea", harder: "jaz"},
+		{name: "phrase", easier: "coffeetable", harder: "coffee table"},
+		{name: "case", easier: "tea", harder: "JAZ"},
+		// Letters missing from the frequencies are neither the most common nor the rarest
+		{name: "cyrillic rarity", easier: "кот", harder: "jaz"},
+		{name: "cyrillic length", easier: "кот", harder: "кошка"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Less(t, domain.WordDifficultyScore(tt.easier, nil), domain.WordDifficultyScore(tt.harder, nil))
+		})
+	}
+
+	assert.Equal(t, 0.0, domain.WordDifficultyScore(" ", nil))
+	// Revealed characters are not guessed, so they do not make a word harder
+	assert.Equal(t, domain.WordDifficultyScore("tictactoe", nil), domain.WordDifficultyScore("tic-tac-toe", nil))
+
+	// The english frequencies do not tell rare cyrillic letters, the frequencies counted from the words do
+	frequencies := domain.CountLetterFrequencies([]string{"кот", "кит", "Ток", "щука"})
+	assert.Equal(t, domain.WordDifficultyScore("кот", nil), domain.WordDifficultyScore("щук", nil))
+	assert.Less(t, domain.WordDifficultyScore("кот", frequencies), domain.WordDifficultyScore("щук", frequencies))
+	assert.Equal(t, domain.WordDifficultyScore("tea", nil), domain.WordDifficultyScore("tea", domain.EnglishLetterFrequencies))
+}
+
+func TestLetterFrequencies(t *testing.T) {
+	assertInstance := assert.New(t)
+
+	assertInstance.True(domain.EnglishLetterFrequencies.Covers(nil))
+	assertInstance.True(domain.EnglishLetterFrequencies.Covers(domain.LatinAlphabet))
+	assertInstance.False(domain.EnglishLetterFrequencies.Covers(domain.RussianAlphabet))
+	assertInstance.False(domain.EnglishLetterFrequencies.Covers(domain.GermanAlphabet))
+	assertInstance.False(domain.CountLetterFrequencies(nil).Covers(domain.LatinAlphabet))
+
+	// Unbucketed words of a collection in another alphabet are classified by the letters of the collection
+	collectionJSON := &domain.WordsCollectionJSON{
+		Alphabet: &domain.AlphabetJSON{Name: "russian"},
+		Categories: []domain.CategoryJSON{
+			{Name: "Животные", EasyWords: []domain.WordJSON{{Word: "кот"}, {Word: "кит"}, {Word: "ток"}}},
+			{Name: "Рыбы", Words: []domain.WordJSON{{Word: "щук"}, {Word: "кот"}}},
+		},
+	}
+
+	collection, err := collectionJSON.ToDomain()
+	assertInstance.NoError(err)
+	assertInstance.Equal([]domain.Word{{Word: "кот"}}, collection.Categories[1].WordsByLevel[domain.EasyDifficulty])
+	assertInstance.Equal([]domain.Word{{Word: "щук"}}, collection.Categories[1].WordsByLevel[domain.MediumDifficulty])
+}
+
+func TestClassifyWords(t *testing.T) {
+	tests := []struct {
+		name           string
+		words          []string
+		expectedEasy   []string
+		expectedMedium []string
+		expectedHard   []string
+	}{
+		{
+			name:           "equal buckets",
+			words:          []string{"rocking chair", "bed", "jazz", "table", "sofa", "wardrobe"},
+			expectedEasy:   []string{"bed", "sofa"},
+			expectedMedium: []string{"jazz", "table"},
+			expectedHard:   []string{"wardrobe", "rocking chair"},
+		},
+		{
+			name:           "remainder goes to easier buckets",
+			words:          []string{"wardrobe", "sofa", "bed", "table"},
+			expectedEasy:   []string{"bed", "sofa"},
+			expectedMedium: []string{"table"},
+			expectedHard:   []string{"wardrobe"},
+		},
+		{
+			name:           "single word",
+			words:          []string{"bed"},
+			expectedEasy:   []string{"bed"},
+			expectedMedium: []string{},
+			expectedHard:   []string{},
+		},
+	}
+
+	toStrings := func(words []domain.Word) []string {
+		result := make([]string, 0, len(words))
+		for _, word := range words {
+			result = append(result, word.Word)
+		}
+
+		return result
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			words := make([]domain.Word, 0, len(tt.words))
+			for _, word := range tt.words {
+				words = append(words, domain.Word{Word: word})
+			}
+
+			wordsByLevel := domain.ClassifyWords(words, domain.DefaultDifficultyLevels.Count(), nil)
+
+			assert.Equal(t, tt.expectedEasy, toStrings(wordsByLevel[domain.EasyDifficulty]))
+			assert.Equal(t, tt.expectedMedium, toStrings(wordsByLevel[domain.MediumDifficulty]))
+			assert.Equal(t, tt.expectedHard, toStrings(wordsByLevel[domain.HardDifficulty]))
+		})
+	}
+
+	// Collections with more levels get more buckets
+	wordsByLevel := domain.ClassifyWords([]domain.Word{{Word: "wardrobe"}, {Word: "sofa"}, {Word: "bed"}, {Word: "table"}}, 5, nil)
+	assert.Len(t, wordsByLevel, 5)
+	assert.Equal(t, []string{"bed"}, toStrings(wordsByLevel[0]))
+	assert.Empty(t, wordsByLevel[4])
+}
+
+func TestLogRedaction(t *testing.T) {
+	var buffer bytes.Buffer
+
+	logger := slog.New(slog.NewJSONHandler(&buffer, nil))
+	word := &domain.Word{Word: "secret", Hint: "classified"}
+	game := domain.NewGame(&domain.Word{Word: "secret", Hint: "classified"}, domain.LatinAlphabet, 6, domain.DefaultWordGuessPenalty)
+
+	defaultLogger := slog.Default()
+	slog.SetDefault(logger)
+
+	t.Cleanup(func() {
+		domain.SetLogSecrets(false)
+		slog.SetDefault(defaultLogger)
+	})
+
+	tests := []struct {
+		name       string
+		logSecrets bool
+	}{
+		{name: "redacted by default", logSecrets: false},
+		{name: "debug override", logSecrets: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buffer.Reset()
+			domain.SetLogSecrets(tt.logSecrets)
+
+			logger.Info("test",
+				slog.Any("word", word),
+				slog.Any("game", game),
+				slog.Any("guess", domain.NewWordGuess("secret")),
+				domain.SecretAttr("raw", "secret"))
+
+			assert.Equal(t, tt.logSecrets, strings.Contains(buffer.String(), "secret"))
+			assert.Equal(t, tt.logSecrets, strings.Contains(buffer.String(), "classified"))
+			assert.Equal(t, !tt.logSecrets, strings.Contains(buffer.String(), domain.RedactedSecret))
+
+			// Correct and incorrect letters together reveal the word
+			buffer.Reset()
+			game.Guess('q')
+			logger.Info("test", slog.Any("guess", domain.NewLetterGuess('q')))
+
+			assert.Equal(t, tt.logSecrets, strings.Contains(buffer.String(), `"letter":"q"`))
+
+			// Events without a letter log no letter at all
+			buffer.Reset()
+			logger.Info("test", slog.Any("event", &domain.Event{Kind: domain.GameStarted}))
+
+			assert.NotContains(t, buffer.String(), `"letter"`)
+		})
+	}
+
+	assert.Equal(t, "", domain.RedactSecret(""))
+}
+
+func TestNewAlphabet(t *testing.T) {
+	tests := []struct {
+		name        string
+		letters     string
+		revealed    string
+		caseFolding map[rune]rune
+		expectError bool
+	}{
+		{name: "valid", letters: "abc", revealed: " -", expectError: false},
+		{name: "no letters", letters: "", revealed: " ", expectError: true},
+		{name: "repeated letter", letters: "aba", revealed: " ", expectError: true},
+		{name: "uppercase letter", letters: "aBc", revealed: " ", expectError: true},
+		{name: "revealed letter", letters: "abc", revealed: " A", expectError: true},
+		{name: "hidden mark", letters: "abc", revealed: "_", expectError: true},
+		{name: "folded by case folding", letters: "ıi", revealed: " ", caseFolding: map[rune]rune{'I': 'ı', 'İ': 'i'}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewAlphabet(tt.name, tt.letters, tt.revealed, tt.caseFolding)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAlphabetJSONToDomain(t *testing.T) {
+	tests := []struct {
+		name            string
+		alphabetJSON    *domain.AlphabetJSON
+		expectedLetters string
+		expectError     bool
+	}{
+		{name: "missing alphabet", alphabetJSON: nil, expectedLetters: "abcdefghijklmnopqrstuvwxyz"},
+		{name: "built-in", alphabetJSON: &domain.AlphabetJSON{Name: "Russian"}, expectedLetters: "абвгдеёжзийклмнопрстуфхцчшщъыьэюя"},
+		{name: "unknown built-in", alphabetJSON: &domain.AlphabetJSON{Name: "klingon"}, expectError: true},
+		{
+			name:            "custom",
+			alphabetJSON:    &domain.AlphabetJSON{Name: "turkish", Letters: "ıi", CaseFolding: map[string]string{"I": "ı", "İ": "i"}},
+			expectedLetters: "ıi",
+		},
+		{
+			name:         "bad case folding",
+			alphabetJSON: &domain.AlphabetJSON{Letters: "ab", CaseFolding: map[string]string{"AB": "a"}},
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alphabet, err := tt.alphabetJSON.ToDomain()
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedLetters, string(alphabet.Letters()))
+			assert.True(t, alphabet.IsRevealed(' '))
+		})
+	}
+}
+
+func TestGameWithAlphabet(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	game := domain.NewGame(&domain.Word{Word: "Ёлка Мама"}, domain.RussianAlphabet, 3, domain.DefaultWordGuessPenalty)
+	assertInstance.Equal("____ ____", game.Pattern())
+
+	// Latin letters and revealed characters are not guesses in the russian alphabet
+	game.Guess('a')
+	game.Guess(' ')
+	assertInstance.Equal(0, game.Attempts())
+
+	game.Guess('Ё')
+	game.Guess('М')
+	assertInstance.Equal("ё___ м_м_", game.Pattern())
+	assertInstance.Equal(0, game.Mistakes())
+
+	game.GuessWord("ЁЛКА МАМА")
+	assertInstance.True(game.IsWin())
+
+	turkish, err := domain.NewAlphabet("turkish", "ıiklm", " ", map[rune]rune{'I': 'ı', 'İ': 'i'})
+	assertInstance.NoError(err)
+
+	game = domain.NewGame(&domain.Word{Word: "KIlIm"}, turkish, 3, domain.DefaultWordGuessPenalty)
+	game.Guess('I')
+	assertInstance.Equal("_ı_ı_", game.Pattern())
+	game.Guess('İ')
+	assertInstance.Equal(1, game.Mistakes())
+}
+
+func TestWordsCollectionAlphabet(t *testing.T) {
+	collectionJSON := &domain.WordsCollectionJSON{
+		Alphabet: &domain.AlphabetJSON{Name: "german"},
+		Categories: []domain.CategoryJSON{
+			{Name: "Essen", EasyWords: []domain.WordJSON{{Word: "Käse"}}},
+		},
+	}
+
+	collection, err := collectionJSON.ToDomain()
+	assert.NoError(t, err)
+	assert.Equal(t, domain.GermanAlphabet, collection.Alphabet)
+	assert.Equal(t, domain.GermanAlphabet, collection.Categories[0].Alphabet)
+
+	collectionJSON.Alphabet = nil
+	_, err = collectionJSON.ToDomain()
+
+	var badWordErr *domain.BadWordError
+	assert.ErrorAs(t, err, &badWordErr)
+}
+
+func TestSavedGameAlphabet(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	game := domain.NewGame(&domain.Word{Word: "ёж"}, domain.RussianAlphabet, 6, domain.DefaultWordGuessPenalty)
+	game.Guess('ж')
+
+	savedGameJSON := (&domain.SavedGame{Category: "Животные", Difficulty: domain.EasyDifficulty, Game: game}).ToJSON()
+
+	savedGame, err := savedGameJSON.ToDomain()
+	assert.NoError(t, err)
+	assert.Equal(t, "_ж", savedGame.Game.Pattern())
+	assert.Equal(t, domain.RussianAlphabet.Letters(), savedGame.Game.Alphabet().Letters())
+
+	// Games saved without an alphabet are played with the latin one
+	savedGameJSON.Alphabet = nil
+	savedGameJSON.Word = domain.WordJSON{Word: "cat"}
+	savedGameJSON.Used = []string{"a"}
+
+	savedGame, err = savedGameJSON.ToDomain()
+	assert.NoError(t, err)
+	assert.Equal(t, domain.LatinAlphabet, savedGame.Game.Alphabet())
+	assert.Equal(t, "_a_", savedGame.Game.Pattern())
+}
+
+func TestDifficultyLevels(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+	levels := domain.DifficultyLevels{{Name: "beginner", MaxMistakes: 8}, {Name: "pro"}, {Name: "master", MaxMistakes: 3}}
+
+	assertInstance.NoError(levels.Validate(nil))
+	assertInstance.Equal(domain.Difficulty(2), levels.Parse("Master"))
+	assertInstance.Equal(domain.UnknownDifficulty, levels.Parse("easy"))
+	assertInstance.Equal(domain.Difficulty(1), levels.Middle())
+	assertInstance.False(levels.IsDefault())
+	assertInstance.True(domain.DefaultDifficultyLevels.IsDefault())
+
+	difficulty := levels.Parse("pro")
+	assertInstance.Equal(domain.Difficulty(1), difficulty)
+	assertInstance.Equal("pro", levels.Name(difficulty))
+	assertInstance.Equal("Pro", levels.Title(difficulty))
+	assertInstance.Equal("unknown", levels.Name(domain.Difficulty(3)))
+	assertInstance.Equal("adaptive", levels.Name(domain.AdaptiveDifficulty))
+	assertInstance.Equal(8, levels.MaxMistakes(domain.Difficulty(0), 0))
+	assertInstance.Equal(domain.StateCount, levels.MaxMistakes(difficulty, 0))
+	assertInstance.Equal(5, levels.MaxMistakes(domain.Difficulty(2), 5))
+
+	// Empty levels are the default ones
+	assertInstance.Equal("Easy", domain.DifficultyLevels(nil).Title(domain.EasyDifficulty))
+	assertInstance.Equal(domain.StateCount, domain.DifficultyLevels(nil).MaxMistakes(domain.HardDifficulty, 0))
+
+	for _, bad := range []domain.DifficultyLevels{
+		{},
+		{{Name: "pro"}, {Name: "pro"}},
+		{{Name: "adaptive"}},
+		{{Name: "two words"}},
+		{{Name: "pro", MaxMistakes: 27}},
+		{{Name: "pro", MaxMistakes: -1}},
+	} {
+		var difficultyErr *domain.BadDifficultyError
+
+		assertInstance.ErrorAs(bad.Validate(nil), &difficultyErr, bad)
+	}
+}
+
+func TestWordsCollectionLevels(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+	collectionJSON := &domain.WordsCollectionJSON{
+		Levels: []domain.DifficultyLevelJSON{{Name: "beginner", HintPolicy: "always"}, {Name: "pro"}},
+		Categories: []domain.CategoryJSON{
+			{
+				Name:   "Animals",
+				Levels: map[string][]domain.WordJSON{"beginner": {{Word: "cat"}}, "pro": {{Word: "okapi"}}},
+			},
+			{Name: "Fruits", Words: []domain.WordJSON{{Word: "fig"}, {Word: "pomegranate"}}},
+		},
+	}
+
+	collection, err := collectionJSON.ToDomain()
+	assertInstance.NoError(err)
+	assertInstance.Equal(domain.AlwaysHints{}, collection.Levels[0].HintPolicy)
+	assertInstance.Equal([][]domain.Word{{{Word: "cat"}}, {{Word: "okapi"}}}, collection.Categories[0].WordsByLevel)
+	assertInstance.Equal([][]domain.Word{{{Word: "fig"}}, {{Word: "pomegranate"}}}, collection.Categories[1].WordsByLevel)
+
+	// The default lists are not levels of a collection that declares its own
+	collectionJSON.Categories = []domain.CategoryJSON{{Name: "Animals", EasyWords: []domain.WordJSON{{Word: "cat"}}}}
+
+	_, err = collectionJSON.ToDomain()
+
+	var categoryErr *domain.BadCategoryError
+
+	assertInstance.ErrorAs(err, &categoryErr)
+
+	// Names differing only in case are merged in the same order on every load
+	categoryJSON := &domain.CategoryJSON{
+		Name:      "Animals",
+		EasyWords: []domain.WordJSON{{Word: "ant"}},
+		Levels:    map[string][]domain.WordJSON{"easy": {{Word: "cat"}}, "Easy": {{Word: "bee"}}, "EASY": {{Word: "dog"}}},
+	}
+
+	for range 10 {
+		category, err := categoryJSON.ToDomain(domain.DefaultDifficultyLevels, nil)
+		assertInstance.NoError(err)
+		assertInstance.Equal([]domain.Word{{Word: "ant"}, {Word: "dog"}, {Word: "bee"}, {Word: "cat"}}, category.WordsByLevel[0])
+	}
+}
+
+func TestSavedGameLevels(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+	levels := domain.DifficultyLevels{{Name: "beginner", MaxMistakes: 8}, {Name: "pro"}}
+	game := domain.NewGame(&domain.Word{Word: "cat"}, domain.LatinAlphabet, 8, domain.DefaultWordGuessPenalty)
+
+	// The game is saved with its own levels
+	savedGameJSON := (&domain.SavedGame{Category: "Animals", Difficulty: 1, Levels: levels, Game: game}).ToJSON()
+	assertInstance.Equal("pro", savedGameJSON.Difficulty)
+	assertInstance.Len(savedGameJSON.Levels, 2)
+
+	savedGame, err := savedGameJSON.ToDomain()
+	assertInstance.NoError(err)
+	assertInstance.Equal(domain.Difficulty(1), savedGame.Difficulty)
+	assertInstance.Equal(levels, savedGame.Levels)
+
+	// Games saved with the default levels do not keep them
+	savedGameJSON = (&domain.SavedGame{Category: "Animals", Difficulty: domain.HardDifficulty, Game: game}).ToJSON()
+	assertInstance.Nil(savedGameJSON.Levels)
+	assertInstance.Equal("hard", savedGameJSON.Difficulty)
+}
+
+func TestSavedGameVersion(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	savedGameJSON := &domain.SavedGameJSON{
+		Category:   "Animals",
+		Difficulty: "easy",
+		GameJSON:   domain.GameJSON{MaxMistakes: 6, Word: domain.WordJSON{Word: "cat"}},
+	}
+
+	for _, tt := range []struct {
+		version int
+		valid   bool
+	}{
+		{version: 0},
+		{version: domain.SavedGameVersion, valid: true},
+		{version: domain.SavedGameVersion + 1},
+	} {
+		savedGameJSON.Version = tt.version
+
+		_, err := savedGameJSON.ToDomain()
+		if tt.valid {
+			assert.NoError(t, err, tt.version)
+		} else {
+			var savedGameErr *domain.BadSavedGameError
+
+			assert.ErrorAs(t, err, &savedGameErr, tt.version)
+		}
+	}
+}
+
+func TestDiacriticsNormalization(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	latin := domain.LatinAlphabet.WithNormalization(domain.DiacriticsNormalization)
+	game := domain.NewGame(&domain.Word{Word: "Crème brûlée"}, latin, 6, domain.DefaultWordGuessPenalty)
+
+	game.Guess('e')
+	assertInstance.Equal("__è_e ____ée", game.Pattern())
+
+	game.Guess('Û')
+	assertInstance.Equal("__è_e __û_ée", game.Pattern())
+	assertInstance.Equal(0, game.Mistakes())
+
+	// The whole word can be typed without accents
+	game.GuessWord("creme brulee")
+	assertInstance.True(game.IsWin())
+
+	russian := domain.RussianAlphabet.WithNormalization(domain.DiacriticsNormalization)
+	assertInstance.Equal(domain.RussianAlphabet.Size()-1, russian.Size())
+	assertInstance.NotContains(russian.Letters(), 'ё')
+
+	game = domain.NewGame(&domain.Word{Word: "Ёлка"}, russian, 6, domain.DefaultWordGuessPenalty)
+	game.Guess('е')
+	assertInstance.Equal("ё___", game.Pattern())
+
+	// Without normalization accented letters are separate letters
+	game = domain.NewGame(&domain.Word{Word: "ёлка"}, domain.RussianAlphabet, 6, domain.DefaultWordGuessPenalty)
+	game.Guess('е')
+	assertInstance.Equal("____", game.Pattern())
+	assertInstance.Equal(1, game.Mistakes())
+}
+
+func TestNormalizationJSONToDomain(t *testing.T) {
+	normalization, err := (*domain.NormalizationJSON)(nil).ToDomain()
+	assert.NoError(t, err)
+	assert.Equal(t, domain.NoNormalization, normalization)
+
+	_, err = (&domain.NormalizationJSON{Policy: "phonetic"}).ToDomain()
+	assert.Error(t, err)
+
+	normalization, err = (&domain.NormalizationJSON{Equivalents: map[string]string{"ё": "е"}}).ToDomain()
+	assert.NoError(t, err)
+	assert.Equal(t, 'е', domain.RussianAlphabet.WithNormalization(normalization).Normalize('Ё'))
+	assert.Equal(t, 'é', domain.LatinAlphabet.WithNormalization(normalization).Normalize('É'))
+
+	// Custom equivalents are kept when a game is saved
+	assert.Equal(t, &domain.NormalizationJSON{Policy: "none", Equivalents: map[string]string{"ё": "е"}}, normalization.ToJSON())
+}
+
+func TestGameRevealedCharacters(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	tests := []struct {
+		name            string
+		word            string
+		letters         string
+		expectedPattern string
+	}{
+		{name: "apostrophes", word: "Rock 'n' Roll", letters: "", expectedPattern: "____ '_' ____"},
+		{name: "hyphens", word: "tic-tac-toe", letters: "t", expectedPattern: "t__-t__-t__"},
+		{name: "digits and punctuation", word: "Catch-22!", letters: "c", expectedPattern: "c__c_-22!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := domain.NewGame(&domain.Word{Word: tt.word}, nil, 6, domain.DefaultWordGuessPenalty)
+
+			for _, letter := range tt.letters {
+				game.Guess(letter)
+			}
+
+			assert.Equal(t, tt.expectedPattern, game.Pattern())
+			assert.Equal(t, 0, game.Mistakes())
+			assert.False(t, game.IsWin())
+
+			// Revealed characters can not be guessed
+			game.Guess('-')
+			assert.Equal(t, len(tt.letters), game.Attempts())
+		})
+	}
+
+	game := domain.NewGame(&domain.Word{Word: "o'clock"}, nil, 6, domain.DefaultWordGuessPenalty)
+	for _, letter := range "oclk" {
+		game.Guess(letter)
+	}
+
+	assert.True(t, game.IsWin())
+
+	alphabet, err := (&domain.AlphabetJSON{Name: "latin", Revealed: " "}).ToDomain()
+	assert.NoError(t, err)
+	assert.Error(t, (&domain.Word{Word: "o'clock"}).Validate(alphabet))
+}
+
+func TestHintPolicies(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	hints := []string{"vague", "closer", "specific"}
+
+	tests := []struct {
+		name      string
+		policy    string
+		mistakes  int
+		requested int
+		expected  []string
+	}{
+		{name: "never", policy: "never", mistakes: 5, expected: []string{}},
+		{name: "always", policy: "always", mistakes: 0, expected: hints},
+		{name: "half before", policy: "half", mistakes: 2, expected: []string{}},
+		{name: "half after", policy: "half", mistakes: 3, expected: hints},
+		{name: "after mistakes", policy: "after:1", mistakes: 1, expected: hints},
+		{name: "on request", policy: "request:2", mistakes: 4, requested: 2, expected: hints[:2]},
+		{name: "progressive first", policy: "progressive", mistakes: 2, expected: hints[:1]},
+		{name: "progressive all", policy: "progressive", mistakes: 5, expected: hints},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := domain.ParseHintPolicy(tt.policy)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.policy, policy.String())
+
+			word := &domain.Word{Word: "giraffe", Hint: hints[0], MoreHints: hints[1:]}
+			game := domain.NewGame(word, nil, 6, domain.DefaultWordGuessPenalty)
+			game.SetHintPolicy(policy)
+
+			for range tt.requested {
+				game.RequestHint()
+			}
+
+			for _, letter := range "zyxwvq"[:tt.mistakes-tt.requested*2] {
+				game.Guess(letter)
+			}
+
+			assert.Equal(t, tt.expected, game.Hints())
+		})
+	}
+
+	for _, policy := range []string{"sometimes", "after", "after:x", "request:-1", "never:1"} {
+		_, err := domain.ParseHintPolicy(policy)
+		assert.Error(t, err, policy)
+	}
+}
+
+func TestGameHintRequest(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	word := (&domain.WordJSON{Word: "giraffe", Hint: "An animal", Hints: []string{"Has a long neck"}}).ToDomain()
+	game := domain.NewGame(word, nil, 6, domain.DefaultWordGuessPenalty)
+
+	// The default policy does not give hints on request
+	game.MakeGuess(domain.NewHintRequest())
+	assertInstance.Equal(0, game.Mistakes())
+	assertInstance.Empty(game.Hints())
+
+	game.SetHintPolicy(domain.OnRequestHints{Cost: 1})
+
+	cost, ok := game.HintRequestCost()
+	assertInstance.True(ok)
+	assertInstance.Equal(1, cost)
+
+	game.MakeGuess(domain.NewHintRequest())
+	assertInstance.Equal([]string{"An animal"}, game.Hints())
+	assertInstance.Equal(1, game.Mistakes())
+	assertInstance.Equal(0, game.Attempts())
+
+	game.MakeGuess(domain.NewHintRequest())
+	assertInstance.Equal([]string{"An animal", "Has a long neck"}, game.Hints())
+
+	// There are no more hints to request
+	_, ok = game.HintRequestCost()
+	assertInstance.False(ok)
+
+	game.MakeGuess(domain.NewHintRequest())
+	assertInstance.Equal(2, game.Mistakes())
+
+	// Saved games are set up before they start, so their events lead to their state
+	game = domain.NewGame(word, nil, 6, domain.DefaultWordGuessPenalty)
+	game.SetHintPolicy(domain.OnRequestHints{Cost: 1})
+	game.MakeGuess(domain.NewHintRequest())
+	game.MakeGuess(domain.NewHintRequest())
+
+	savedGameJSON := (&domain.SavedGame{Category: "Animals", Difficulty: domain.EasyDifficulty, Game: game}).ToJSON()
+	assertInstance.Equal("request:1", savedGameJSON.HintPolicy)
+
+	savedGame, err := savedGameJSON.ToDomain()
+	assertInstance.NoError(err)
+	assertInstance.Equal(game.Hints(), savedGame.Game.Hints())
+	assertInstance.Equal(domain.OnRequestHints{Cost: 1}, savedGame.Game.HintPolicy())
+
+	// Games saved without a hint policy use the default one
+	savedGameJSON.Events = nil
+	savedGameJSON.HintPolicy = ""
+	savedGameJSON.RequestedHints = 0
+
+	savedGame, err = savedGameJSON.ToDomain()
+	assertInstance.NoError(err)
+	assertInstance.Equal(domain.DefaultHintPolicy, savedGame.Game.HintPolicy())
+
+	savedGameJSON.RequestedHints = 3
+	_, err = savedGameJSON.ToDomain()
+	assertInstance.Error(err)
+}
+
+func TestGameBuyLetter(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	game := domain.NewGame(&domain.Word{Word: "abba"}, nil, 6, domain.DefaultWordGuessPenalty)
+
+	// Games have no power-ups unless they are set
+	assertInstance.False(game.CanBuyLetter())
+	game.MakeGuess(domain.NewLetterPurchase())
+	assertInstance.Equal("____", game.Pattern())
+
+	game.SetPowerUps(domain.PowerUps{Letters: 3, LetterCost: 1})
+	game.Guess('a')
+
+	game.MakeGuess(domain.NewLetterPurchase())
+	assertInstance.Equal("abba", game.Pattern())
+	assertInstance.Equal(1, game.Mistakes())
+	assertInstance.Equal(1, game.Attempts())
+	assertInstance.Equal(domain.PowerUps{Letters: 2, LetterCost: 1}, game.PowerUps())
+	assertInstance.True(game.IsWin())
+
+	// Bought letters do not score
+	assertInstance.Equal(2*domain.LetterScore+5*domain.RemainingMistakesMax/6, domain.RoundScore(game, domain.EasyDifficulty))
+
+	// Saved games are set up before they start, so their events lead to their state
+	game = domain.NewGame(&domain.Word{Word: "abba"}, nil, 6, domain.DefaultWordGuessPenalty)
+	game.SetPowerUps(domain.PowerUps{Letters: 3, LetterCost: 1})
+	game.MakeGuess(domain.NewLetterGuess('a'))
+	game.MakeGuess(domain.NewLetterPurchase())
+
+	savedGame, err := (&domain.SavedGame{Difficulty: domain.EasyDifficulty, Game: game}).ToJSON().ToDomain()
+	assertInstance.NoError(err)
+	assertInstance.Equal(game.PowerUps(), savedGame.Game.PowerUps())
+	assertInstance.Equal(domain.RoundScore(game, domain.EasyDifficulty), domain.RoundScore(savedGame.Game, domain.EasyDifficulty))
+
+	// A letter is not sold when its cost would lose the game
+	game = domain.NewGame(&domain.Word{Word: "cat"}, nil, 2, domain.DefaultWordGuessPenalty)
+	game.SetPowerUps(domain.PowerUps{Letters: 1, LetterCost: 2})
+	game.BuyLetter()
+	assertInstance.Equal("___", game.Pattern())
+	assertInstance.Equal(0, game.Mistakes())
+
+	game.SetPowerUps(domain.PowerUps{Letters: 1, LetterCost: 0})
+	game.BuyLetter()
+	assertInstance.Equal(2, strings.Count(game.Pattern(), string(domain.HiddenLetter)))
+	assertInstance.False(game.CanBuyLetter())
+}
+
+func TestGameTimeouts(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	game := domain.NewGame(&domain.Word{Word: "cat"}, nil, 2, domain.DefaultWordGuessPenalty)
+
+	game.MakeGuess(domain.NewGuessTimeout())
+	assertInstance.Equal(1, game.Mistakes())
+	assertInstance.Equal(1, game.Attempts())
+	assertInstance.False(game.IsFinished())
+
+	game.MakeGuess(domain.NewGameTimeout())
+	assertInstance.True(game.IsLose())
+	assertInstance.Equal(1, game.Mistakes())
+
+	evilGame := domain.NewEvilGame(&domain.Word{Word: "cat"}, []domain.Word{{Word: "cat"}, {Word: "dog"}}, nil, 2,
+		domain.DefaultWordGuessPenalty)
+
+	evilGame.MakeGuess(domain.NewGuessTimeout())
+	evilGame.MakeGuess(domain.NewGuessTimeout())
+	evilGame.MakeGuess(domain.NewGuessTimeout())
+	assertInstance.True(evilGame.IsLose())
+	assertInstance.Equal(2, evilGame.Mistakes())
+}
+
+func TestGameUndo(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	game := domain.NewGame(&domain.Word{Word: "cat", Hint: "An animal"}, nil, 6, domain.DefaultWordGuessPenalty)
+	game.SetHintPolicy(domain.OnRequestHints{Cost: 1})
+
+	// Guesses are not taken back outside practice games
+	game.MakeGuess(domain.NewLetterGuess('x'))
+	assertInstance.False(game.CanUndo())
+	game.MakeGuess(domain.NewUndoRequest())
+	assertInstance.Equal(1, game.Mistakes())
+
+	game.SetPractice(true)
+	game.MakeGuess(domain.NewLetterGuess('c'))
+	game.MakeGuess(domain.NewLetterGuess('c'))
+	game.MakeGuess(domain.NewWordGuess("Dog"))
+	game.MakeGuess(domain.NewHintRequest())
+	assertInstance.Equal([]domain.Guess{
+		*domain.NewLetterGuess('x'), *domain.NewLetterGuess('c'), *domain.NewWordGuess("dog"), *domain.NewHintRequest(),
+	}, game.History())
+
+	game.MakeGuess(domain.NewUndoRequest())
+	assertInstance.Empty(game.Hints())
+	assertInstance.Equal(3, game.Mistakes())
+
+	game.MakeGuess(domain.NewUndoRequest())
+	assertInstance.Equal(1, game.Mistakes())
+	assertInstance.Equal(2, game.Attempts())
+
+	// The word can be guessed again once it is taken back
+	game.MakeGuess(domain.NewWordGuess("dog"))
+	assertInstance.Equal(3, game.Mistakes())
+	game.Undo()
+
+	game.Undo()
+	assertInstance.Equal("___", game.Pattern())
+	assertInstance.False(game.Used()['c'])
+
+	game.Undo()
+	assertInstance.Equal(0, game.Mistakes())
+	assertInstance.Equal(0, game.Attempts())
+	assertInstance.False(game.Used()['x'])
+	assertInstance.Empty(game.History())
+	assertInstance.False(game.CanUndo())
+}
+
+func TestGameEvents(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	game := domain.NewGame(&domain.Word{Word: "cat", Hint: "An animal"}, nil, 4, domain.DefaultWordGuessPenalty)
+	game.SetPowerUps(domain.PowerUps{Letters: 1, LetterCost: 1})
+	game.SetPractice(true)
+	game.Start()
+
+	game.MakeGuess(domain.NewLetterGuess('x'))
+	game.MakeGuess(domain.NewLetterGuess('x'))
+	game.MakeGuess(domain.NewLetterPurchase())
+	game.MakeGuess(domain.NewUndoRequest())
+	game.MakeGuess(domain.NewLetterGuess('C'))
+	game.MakeGuess(domain.NewWordGuess("cat"))
+
+	kinds := make([]domain.EventKind, 0, len(game.Events()))
+	for _, event := range game.Events() {
+		kinds = append(kinds, event.Kind)
+	}
+
+	// Repeated letters do not change the game, so they are not events
+	assertInstance.Equal([]domain.EventKind{
+		domain.GameStarted, domain.LetterGuessed, domain.LetterBought, domain.HintShown, domain.GuessTakenBack,
+		domain.LetterGuessed, domain.WordGuessed, domain.GameWon,
+	}, kinds)
+	assertInstance.False(game.Events()[1].Hit)
+	assertInstance.Equal('c', game.Events()[5].Letter)
+	assertInstance.True(game.Events()[5].Hit)
+
+	steps := 0
+
+	replayed, err := domain.ReplayEvents(game.Events(), func(*domain.Game, *domain.Event) {
+		steps++
+	})
+	assertInstance.NoError(err)
+	assertInstance.Equal(len(game.Events()), steps)
+	assertInstance.Equal(game.ToJSON(), replayed.ToJSON())
+	assertInstance.Equal(game.Events(), replayed.Events())
+	assertInstance.Equal(game.History(), replayed.History())
+
+	_, err = domain.ReplayEvents(game.Events()[1:], nil)
+	assertInstance.Error(err)
+
+	// The replayed guesses must emit the recorded events
+	events := append([]domain.Event{}, game.Events()...)
+	events[1].Hit = true
+
+	_, err = domain.ReplayEvents(events, nil)
+	assertInstance.Error(err)
+}
+
+func TestSavedGameEvents(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	game := domain.NewGame(&domain.Word{Word: "cat"}, nil, 6, domain.DefaultWordGuessPenalty)
+	game.SetPractice(true)
+	game.MakeGuess(domain.NewLetterGuess('c'))
+	game.MakeGuess(domain.NewLetterGuess('x'))
+
+	// Replaying the saved events restores the practice history
+	savedGame, err := (&domain.SavedGame{Difficulty: domain.EasyDifficulty, Game: game}).ToJSON().ToDomain()
+	assertInstance.NoError(err)
+	assertInstance.Equal(game.Events(), savedGame.Game.Events())
+	assertInstance.True(savedGame.Game.CanUndo())
+
+	savedGame.Game.MakeGuess(domain.NewUndoRequest())
+	savedGame.Game.MakeGuess(domain.NewUndoRequest())
+	assertInstance.Equal("___", savedGame.Game.Pattern())
+
+	// Events that do not lead to the saved state mean the save is corrupted
+	savedGameJSON := (&domain.SavedGame{Difficulty: domain.EasyDifficulty, Game: game}).ToJSON()
+	savedGameJSON.Mistakes = 0
+
+	_, err = savedGameJSON.ToDomain()
+	assertInstance.Error(err)
+
+	// Events of unknown kind are rejected
+	savedGameJSON = (&domain.SavedGame{Difficulty: domain.EasyDifficulty, Game: game}).ToJSON()
+	savedGameJSON.Events[1].Kind = "letterForgotten"
+
+	_, err = savedGameJSON.ToDomain()
+	assertInstance.Error(err)
+}
+
+func TestProfileStats(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	tests := []struct {
+		name          string
+		games         []domain.GameResult
+		total         domain.WinRate
+		byCategory    map[string]domain.WinRate
+		currentStreak int
+		bestStreak    int
+		mostMissed    []domain.LetterCount
+	}{
+		{
+			name:       "no games",
+			byCategory: map[string]domain.WinRate{},
+		},
+		{
+			name: "streak broken by a loss",
+			games: []domain.GameResult{
+				{Category: "Animals", Won: true, Missed: []rune{'e', 'a'}},
+				{Category: "Animals", Won: true},
+				{Category: "Fruits", Won: false, Missed: []rune{'e', 's', 'a', 'z'}},
+				{Category: "Animals", Won: true, Missed: []rune{'e'}},
+			},
+			total:         domain.WinRate{Games: 4, Wins: 3},
+			byCategory:    map[string]domain.WinRate{"Animals": {Games: 3, Wins: 3}, "Fruits": {Games: 1, Wins: 0}},
+			currentStreak: 1,
+			bestStreak:    2,
+			mostMissed: []domain.LetterCount{
+				{Letter: 'e', Count: 3}, {Letter: 'a', Count: 2}, {Letter: 's', Count: 1}, {Letter: 'z', Count: 1},
+			},
+		},
+		{
+			name: "lost last game",
+			games: []domain.GameResult{
+				{Category: "Fruits", Won: true, Missed: []rune{'a', 'b', 'c', 'd', 'e', 'f'}},
+				{Category: "Fruits", Won: false},
+			},
+			total:      domain.WinRate{Games: 2, Wins: 1},
+			byCategory: map[string]domain.WinRate{"Fruits": {Games: 2, Wins: 1}},
+			bestStreak: 1,
+			mostMissed: []domain.LetterCount{
+				{Letter: 'a', Count: 1}, {Letter: 'b', Count: 1}, {Letter: 'c', Count: 1}, {Letter: 'd', Count: 1}, {Letter: 'e', Count: 1},
+			},
+		},
+	}
+
+	assertInstance := assert.New(t)
+
+	for _, tt := range tests {
+		profile := &domain.Profile{Name: "player", Games: tt.games}
+		stats := profile.Stats()
+
+		assertInstance.Equal(tt.total, stats.Total, tt.name)
+		assertInstance.Equal(tt.byCategory, stats.ByCategory, tt.name)
+		assertInstance.Equal(tt.currentStreak, stats.CurrentStreak, tt.name)
+		assertInstance.Equal(tt.bestStreak, stats.BestStreak, tt.name)
+		assertInstance.Equal(tt.mostMissed, stats.MostMissed, tt.name)
+	}
+
+	assertInstance.InDelta(75.0, domain.WinRate{Games: 4, Wins: 3}.Percent(), 0.001)
+	assertInstance.Zero(domain.WinRate{}.Percent())
+}
+
+func TestProfileJSON(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	game := domain.NewGame(&domain.Word{Word: "cat"}, nil, 6, domain.DefaultWordGuessPenalty)
+	game.MakeGuess(domain.NewLetterGuess('x'))
+	game.MakeGuess(domain.NewWordGuess("cat"))
+
+	result := domain.NewGameResult(&domain.SavedGame{Category: "Animals", Difficulty: domain.EasyDifficulty, Game: game})
+	assertInstance.True(result.Won)
+	assertInstance.Equal([]rune{'x'}, result.Missed)
+	assertInstance.Equal(2, result.Attempts)
+
+	profile := &domain.Profile{Name: "player"}
+	profile.AddGame(result)
+
+	restored, err := profile.ToJSON().ToDomain()
+	assertInstance.NoError(err)
+	assertInstance.Equal(profile, restored)
+
+	profileJSON := profile.ToJSON()
+	profileJSON.Name = "../player"
+
+	_, err = profileJSON.ToDomain()
+	assertInstance.Error(err)
+
+	profileJSON = profile.ToJSON()
+	profileJSON.Games[0].Duration = "long"
+
+	_, err = profileJSON.ToDomain()
+	assertInstance.Error(err)
+}
+
+func TestEvilAndPlayerResults(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	// The evil game ends on the word left, the letters it dodged are missed
+	pool := []domain.Word{{Word: "cat"}, {Word: "dog"}}
+	evil := domain.NewEvilGame(&domain.Word{Word: "cat"}, pool, nil, 6, domain.DefaultWordGuessPenalty)
+	evil.MakeGuess(domain.NewLetterGuess('c'))
+	evil.MakeGuess(domain.NewWordGuess("dog"))
+
+	result := domain.NewEvilGameResult("Animals", "easy", evil)
+	assertInstance.True(result.Won)
+	assertInstance.Equal("dog", result.Word)
+	assertInstance.Equal([]rune{'c'}, result.Missed)
+	assertInstance.Equal(2, result.Attempts)
+	assertInstance.False(result.FinishedAt.IsZero())
+
+	// Hot seat players get their own mistakes and missed letters
+	hotSeat, err := domain.NewHotSeatGame(
+		&domain.Word{Word: "ab"}, nil, 6, domain.DefaultWordGuessPenalty, []string{"Alice", "Bob"}, domain.SharedMistakes,
+	)
+	assertInstance.NoError(err)
+
+	hotSeat.MakeGuess(domain.NewLetterGuess('z'))
+	hotSeat.MakeGuess(domain.NewLetterGuess('y'))
+	hotSeat.MakeGuess(domain.NewLetterGuess('x'))
+	hotSeat.MakeGuess(domain.NewLetterGuess('a'))
+	hotSeat.MakeGuess(domain.NewLetterGuess('b'))
+
+	alice := domain.NewPlayerResult("Letters", "hard", hotSeat, &hotSeat.Players()[0])
+	assertInstance.True(alice.Won)
+	assertInstance.Equal([]rune{'x', 'z'}, alice.Missed)
+	assertInstance.Equal(2, alice.Mistakes)
+	assertInstance.Equal(3, alice.Attempts)
+
+	bob := domain.NewPlayerResult("Letters", "hard", hotSeat, &hotSeat.Players()[1])
+	assertInstance.False(bob.Won)
+	assertInstance.Equal([]rune{'y'}, bob.Missed)
+	assertInstance.Equal("ab", bob.Word)
+}
+
+func TestParseRepeatPolicy(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	tests := []struct {
+		value       string
+		expected    domain.RepeatPolicy
+		expectError bool
+	}{
+		{value: "any", expected: domain.RepeatPolicy{Mode: domain.AnyWord}},
+		{value: "recent", expected: domain.RepeatPolicy{Mode: domain.AvoidRecent, Recent: domain.DefaultRecentGames}},
+		{value: " Recent:3 ", expected: domain.RepeatPolicy{Mode: domain.AvoidRecent, Recent: 3}},
+		{value: "deck", expected: domain.RepeatPolicy{Mode: domain.ShuffledDeck}},
+		{value: "recent:0", expectError: true},
+		{value: "recent:1000", expectError: true},
+		{value: "deck:2", expectError: true},
+		{value: "never", expectError: true},
+	}
+
+	assertInstance := assert.New(t)
+
+	for _, tt := range tests {
+		policy, err := domain.ParseRepeatPolicy(tt.value)
+
+		if tt.expectError {
+			assertInstance.Error(err, tt.value)
+			continue
+		}
+
+		assertInstance.NoError(err, tt.value)
+		assertInstance.Equal(tt.expected, policy, tt.value)
+
+		parsed, err := domain.ParseRepeatPolicy(policy.String())
+		assertInstance.NoError(err, tt.value)
+		assertInstance.Equal(policy, parsed, tt.value)
+	}
+}
+
+func TestWordHistory(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+	words := []domain.Word{{Word: "ant"}, {Word: "bee"}, {Word: "cat"}, {Word: "dog"}}
+	random := rand.New(rand.NewPCG(1, 2))
+
+	choose := func(history *domain.WordHistory, words []domain.Word, policy domain.RepeatPolicy) string {
+		word, err := history.Choose(words, "Animals/easy", policy, random)
+		assertInstance.NoError(err)
+
+		return word.Word
+	}
+
+	// Words of the last two games are avoided
+	history := &domain.WordHistory{}
+	recent := domain.RepeatPolicy{Mode: domain.AvoidRecent, Recent: 2}
+
+	for range 20 {
+		lastTwo := history.Recent[max(0, len(history.Recent)-2):]
+		assertInstance.NotContains(lastTwo, choose(history, words, recent))
+	}
+
+	assertInstance.Len(history.Recent, 20)
+
+	// When all words are recent the one played longest ago is chosen
+	history = &domain.WordHistory{Recent: []string{"bee", "ant"}}
+	assertInstance.Equal("bee", choose(history, words[:2], recent))
+	assertInstance.Equal([]string{"bee", "ant", "bee"}, history.Recent)
+
+	// Every word of the deck is played once before the deck is shuffled again
+	history = &domain.WordHistory{}
+	deck := domain.RepeatPolicy{Mode: domain.ShuffledDeck}
+	played := make(map[string]int)
+
+	for range 2 * len(words) {
+		word, err := history.Choose(words, "Animals/easy", deck, random)
+		assertInstance.NoError(err)
+
+		played[word.Word]++
+
+		if len(played) < len(words) {
+			assertInstance.LessOrEqual(played[word.Word], 1)
+		}
+	}
+
+	assertInstance.Equal(map[string]int{"ant": 2, "bee": 2, "cat": 2, "dog": 2}, played)
+
+	// Words removed from the list are skipped
+	history = &domain.WordHistory{Decks: map[string][]string{"Animals/easy": {"emu", "dog"}}}
+	assertInstance.Equal("dog", choose(history, words, deck))
+	assertInstance.Empty(history.Decks["Animals/easy"])
+
+	_, err := history.Choose(nil, "Animals/easy", deck, random)
+	assertInstance.Error(err)
+}
+
+func TestDailyResult(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+
+	game := domain.NewGame(&domain.Word{Word: "cat"}, nil, 6, domain.DefaultWordGuessPenalty)
+	game.Start()
+
+	result := domain.NewDailyResult("2026-10-18", "hash", game)
+	assertInstance.False(result.Finished)
+	assertInstance.Empty(result.Summary)
+
+	for _, guess := range []*domain.Guess{
+		domain.NewLetterGuess('x'), domain.NewLetterGuess('c'), domain.NewWordGuess("cot"), domain.NewLetterGuess('a'),
+		domain.NewLetterGuess('e'), domain.NewLetterGuess('i'), domain.NewLetterGuess('o'),
+	} {
+		game.MakeGuess(guess)
+	}
+
+	result = domain.NewDailyResult("2026-10-18", "hash", game)
+	assertInstance.True(result.Finished)
+	assertInstance.False(result.Won)
+	assertInstance.Equal("Hangman 2026-10-18 X/6\n🟥🟩❌🟩🟥🟥🟥", result.Summary)
+	assertInstance.NotContains(result.Summary, "cat")
+
+	game = domain.NewGame(&domain.Word{Word: "ab"}, nil, 6, domain.DefaultWordGuessPenalty)
+	for _, letter := range "zyxwvba" {
+		game.MakeGuess(domain.NewLetterGuess(letter))
+	}
+
+	assertInstance.Equal("Hangman 2026-10-18 5/6\n🟥🟥🟥🟥🟥🟩🟩", domain.DailySummary("2026-10-18", game))
+
+	// Only one result is kept for a day and a collection
+	profile := &domain.Profile{Name: "player", Games: []domain.GameResult{}}
+	profile.SetDailyResult(&domain.DailyResult{Date: "2026-10-18", Collection: "hash"})
+	profile.SetDailyResult(result)
+	profile.SetDailyResult(&domain.DailyResult{Date: "2026-10-18", Collection: "other"})
+
+	assertInstance.Len(profile.Daily, 2)
+	assertInstance.Equal(result, profile.DailyResult("2026-10-18", "hash"))
+	assertInstance.Nil(profile.DailyResult("2026-10-19", "hash"))
+
+	restored, err := profile.ToJSON().ToDomain()
+	assertInstance.NoError(err)
+	assertInstance.Equal(profile, restored)
+}
+
+func TestWordsCollectionContentHash(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	collection := &domain.WordsCollection{Categories: []domain.Category{
+		{Name: "Animals", WordsByLevel: [][]domain.Word{{{Word: "cat", Hint: "Meows"}}}},
+	}}
+	hash := collection.ContentHash()
+
+	// Hints do not change the words to play
+	collection.Categories[0].WordsByLevel[0][0].Hint = "A pet"
+	assert.Equal(t, hash, collection.ContentHash())
+
+	collection.Categories[0].WordsByLevel[0] = append(collection.Categories[0].WordsByLevel[0], domain.Word{Word: "dog"})
+	assert.NotEqual(t, hash, collection.ContentHash())
+}
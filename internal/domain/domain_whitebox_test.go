@@ -149,7 +149,7 @@ func TestGameState(t *testing.T) {
 
 	for _, tt := range tests {
 		word := &Word{Word: "test", Hint: "test hint"}
-		game := NewGame(word, tt.maxMistakes)
+		game := NewGame(word, LatinAlphabet, tt.maxMistakes, DefaultWordGuessPenalty)
 		game.mistakes = tt.mistakes
 
 		assertInstance.Equal(tt.expected, game.State(), tt.name)
@@ -174,6 +174,7 @@ func TestNewGame(t *testing.T) {
 				mistakes:       0,
 				maxMistakes:    5,
 				word:           Word{Word: "apple", Hint: "A fruit"},
+				alphabet:       LatinAlphabet,
 				correctLetters: map[rune]bool{'a': true, 'p': true, 'l': true, 'e': true},
 				used:           map[rune]bool{},
 			},
@@ -187,6 +188,7 @@ func TestNewGame(t *testing.T) {
 				mistakes:       0,
 				maxMistakes:    7,
 				word:           Word{Word: "hello world", Hint: "A greeting"},
+				alphabet:       LatinAlphabet,
 				correctLetters: map[rune]bool{'h': true, 'e': true, 'l': true, 'o': true, 'w': true, 'r': true, 'd': true, ' ': true},
 				used:           map[rune]bool{' ': true},
 			},
@@ -195,7 +197,7 @@ func TestNewGame(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			game := NewGame(tt.word, tt.maxMistakes)
+			game := NewGame(tt.word, LatinAlphabet, tt.maxMistakes, DefaultWordGuessPenalty)
 			assert.Equal(t, tt.expected.Attempts(), game.Attempts())
 			assert.Equal(t, tt.expected.Mistakes(), game.Mistakes())
 			assert.Equal(t, tt.expected.MaxMistakes(), game.MaxMistakes())
@@ -273,6 +275,7 @@ func TestPattern(t *testing.T) {
 	for _, tt := range tests {
 		game := &Game{
 			word:           *tt.word,
+			alphabet:       LatinAlphabet,
 			correctLetters: tt.correctLetters,
 			used:           tt.usedLetters,
 		}
@@ -411,6 +414,7 @@ func TestGuess(t *testing.T) {
 			mistakes:       tt.mistakes,
 			maxMistakes:    tt.maxMistakes,
 			word:           *tt.word,
+			alphabet:       LatinAlphabet,
 			correctLetters: tt.correctLetters,
 			used:           tt.usedLetters,
 		}
@@ -421,6 +425,133 @@ func TestGuess(t *testing.T) {
 	}
 }
 
+func TestGuessWord(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	tests := []struct {
+		name             string
+		attempts         int
+		mistakes         int
+		maxMistakes      int
+		word             *Word
+		usedWords        map[string]bool
+		guess            string
+		expectedAttempts int
+		expectedMistakes int
+		expectedWin      bool
+	}{
+		{
+			name:             "Correct word guess",
+			maxMistakes:      6,
+			word:             &Word{Word: "apple", Hint: "A fruit"},
+			usedWords:        map[string]bool{},
+			guess:            "apple",
+			expectedAttempts: 1,
+			expectedMistakes: 0,
+			expectedWin:      true,
+		},
+		{
+			name:             "Correct word guess in upper case",
+			maxMistakes:      6,
+			word:             &Word{Word: "apple", Hint: "A fruit"},
+			usedWords:        map[string]bool{},
+			guess:            "APPLE",
+			expectedAttempts: 1,
+			expectedMistakes: 0,
+			expectedWin:      true,
+		},
+		{
+			name:             "Correct phrase guess",
+			maxMistakes:      6,
+			word:             &Word{Word: "hello world", Hint: "A greeting"},
+			usedWords:        map[string]bool{},
+			guess:            "hello world",
+			expectedAttempts: 1,
+			expectedMistakes: 0,
+			expectedWin:      true,
+		},
+		{
+			name:             "Correct word guess with leading and trailing spaces",
+			maxMistakes:      6,
+			word:             &Word{Word: "apple", Hint: "A fruit"},
+			usedWords:        map[string]bool{},
+			guess:            "  apple ",
+			expectedAttempts: 1,
+			expectedMistakes: 0,
+			expectedWin:      true,
+		},
+		{
+			name:             "Correct phrase guess with doubled spaces",
+			maxMistakes:      6,
+			word:             &Word{Word: "hello world", Hint: "A greeting"},
+			usedWords:        map[string]bool{},
+			guess:            "hello   world",
+			expectedAttempts: 1,
+			expectedMistakes: 0,
+			expectedWin:      true,
+		},
+		{
+			name:             "Repeated word guess with other spaces",
+			attempts:         1,
+			mistakes:         2,
+			maxMistakes:      6,
+			word:             &Word{Word: "apple", Hint: "A fruit"},
+			usedWords:        map[string]bool{"apply": true},
+			guess:            " apply  ",
+			expectedAttempts: 1,
+			expectedMistakes: 2,
+			expectedWin:      false,
+		},
+		{
+			name:             "Incorrect word guess",
+			maxMistakes:      6,
+			word:             &Word{Word: "apple", Hint: "A fruit"},
+			usedWords:        map[string]bool{},
+			guess:            "apply",
+			expectedAttempts: 1,
+			expectedMistakes: 2,
+			expectedWin:      false,
+		},
+		{
+			name:             "Repeated incorrect word guess",
+			attempts:         1,
+			mistakes:         2,
+			maxMistakes:      6,
+			word:             &Word{Word: "apple", Hint: "A fruit"},
+			usedWords:        map[string]bool{"apply": true},
+			guess:            "apply",
+			expectedAttempts: 1,
+			expectedMistakes: 2,
+			expectedWin:      false,
+		},
+		{
+			name:             "Incorrect word guess does not exceed max mistakes",
+			attempts:         5,
+			mistakes:         5,
+			maxMistakes:      6,
+			word:             &Word{Word: "apple", Hint: "A fruit"},
+			usedWords:        map[string]bool{},
+			guess:            "apply",
+			expectedAttempts: 6,
+			expectedMistakes: 6,
+			expectedWin:      false,
+		},
+	}
+
+	assertInstance := assert.New(t)
+
+	for _, tt := range tests {
+		game := NewGame(tt.word, LatinAlphabet, tt.maxMistakes, DefaultWordGuessPenalty)
+		game.attempts = tt.attempts
+		game.mistakes = tt.mistakes
+		game.usedWords = tt.usedWords
+		game.GuessWord(tt.guess)
+		assertInstance.Equal(tt.expectedAttempts, game.Attempts(), tt.name)
+		assertInstance.Equal(tt.expectedMistakes, game.Mistakes(), tt.name)
+		assertInstance.Equal(tt.expectedWin, game.IsWin(), tt.name)
+	}
+}
+
 func TestIsWin(t *testing.T) {
 	log.SetOutput(io.Discard)
 
@@ -517,6 +648,7 @@ func TestIsWin(t *testing.T) {
 			mistakes:       tt.mistakes,
 			maxMistakes:    tt.maxMistakes,
 			word:           *tt.word,
+			alphabet:       LatinAlphabet,
 			correctLetters: tt.correctLetters,
 			used:           tt.usedLetters,
 		}
@@ -590,6 +722,7 @@ func TestIsLose(t *testing.T) {
 			mistakes:       tt.mistakes,
 			maxMistakes:    tt.maxMistakes,
 			word:           *tt.word,
+			alphabet:       LatinAlphabet,
 			correctLetters: tt.correctLetters,
 			used:           tt.usedLetters,
 		}
@@ -673,6 +806,7 @@ func TestIsFinished(t *testing.T) {
 			mistakes:       tt.mistakes,
 			maxMistakes:    tt.maxMistakes,
 			word:           *tt.word,
+			alphabet:       LatinAlphabet,
 			correctLetters: tt.correctLetters,
 			used:           tt.usedLetters,
 		}
@@ -0,0 +1,203 @@
+package domain
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+type EventKind int
+
+const (
+	GameStarted EventKind = iota
+	LetterGuessed
+	WordGuessed
+	HintRequested
+	// HintShown follows the event that made the policy show one more hint.
+	HintShown
+	LetterBought
+	GuessTimedOut
+	GameTimedOut
+	GuessTakenBack
+	GameWon
+	GameLost
+	EventKindCount
+)
+
+func (k EventKind) String() string {
+	return [...]string{
+		"gameStarted", "letterGuessed", "wordGuessed", "hintRequested", "hintShown", "letterBought",
+		"guessTimedOut", "gameTimedOut", "guessTakenBack", "gameWon", "gameLost",
+	}[k]
+}
+
+func ParseEventKind(value string) (kind EventKind, ok bool) {
+	for kind = GameStarted; kind < EventKindCount; kind++ {
+		if kind.String() == value {
+			return kind, true
+		}
+	}
+
+	return 0, false
+}
+
+type EventJSON struct {
+	Kind   string    `json:"kind"`
+	Time   time.Time `json:"time"`
+	Letter string    `json:"letter,omitempty"`
+	Word   string    `json:"word,omitempty"`
+	Hint   string    `json:"hint,omitempty"`
+	Hit    bool      `json:"hit,omitempty"`
+	Game   *GameJSON `json:"game,omitempty"`
+}
+
+func (e *EventJSON) ToDomain() (event *Event, err error) {
+	kind, ok := ParseEventKind(e.Kind)
+	if !ok {
+		return nil, &BadEventError{Message: fmt.Sprintf("unknown kind %q", e.Kind)}
+	}
+
+	event = &Event{Kind: kind, Time: e.Time, Word: e.Word, Hint: e.Hint, Hit: e.Hit, Game: e.Game}
+
+	if e.Letter != "" {
+		letter := []rune(e.Letter)
+		if len(letter) != 1 {
+			return nil, &BadEventError{Message: fmt.Sprintf("letter %q is not a single letter", e.Letter)}
+		}
+
+		event.Letter = letter[0]
+	}
+
+	return event, nil
+}
+
+// Event is a change of a game, replaying the events of a game in order rebuilds it.
+type Event struct {
+	Kind EventKind
+	Time time.Time
+	// Letter is set for guessed and bought letters, Word for guessed words and Hint for shown hints.
+	Letter rune
+	Word   string
+	Hint   string
+	// Hit is set when the guessed letter or word is correct.
+	Hit bool
+	// Game is the state the game started with, it is set only for GameStarted.
+	Game *GameJSON
+}
+
+func (e *Event) ToJSON() *EventJSON {
+	event := &EventJSON{Kind: e.Kind.String(), Time: e.Time, Word: e.Word, Hint: e.Hint, Hit: e.Hit, Game: e.Game}
+
+	if e.Letter != 0 {
+		event.Letter = string(e.Letter)
+	}
+
+	return event
+}
+
+// guess returns the guess that caused the event, it is nil for the events that follow from other ones.
+func (e *Event) guess() *Guess {
+	switch e.Kind {
+	case LetterGuessed:
+		return NewLetterGuess(e.Letter)
+	case WordGuessed:
+		return NewWordGuess(e.Word)
+	case HintRequested:
+		return NewHintRequest()
+	case LetterBought:
+		return &Guess{Kind: LetterPurchase, Letter: e.Letter}
+	case GuessTimedOut:
+		return NewGuessTimeout()
+	case GameTimedOut:
+		return NewGameTimeout()
+	case GuessTakenBack:
+		return NewUndoRequest()
+	default:
+		return nil
+	}
+}
+
+func (e *Event) LogValue() slog.Value {
+	attrs := []slog.Attr{slog.String("kind", e.Kind.String()), slog.Time("time", e.Time)}
+	if e.Letter != 0 {
+		attrs = append(attrs, SecretAttr("letter", string(e.Letter)))
+	}
+
+	attrs = append(attrs, SecretAttr("word", e.Word), SecretAttr("hint", e.Hint), slog.Bool("hit", e.Hit))
+
+	return slog.GroupValue(attrs...)
+}
+
+// sameAs compares what happened, the times are compared by the clock of the replay.
+func (e *Event) sameAs(other *Event) bool {
+	return e.Kind == other.Kind && e.Letter == other.Letter && e.Word == other.Word && e.Hint == other.Hint &&
+		e.Hit == other.Hit
+}
+
+// replayClock gives every emitted event the time of the recorded event it replays.
+type replayClock struct {
+	game   *Game
+	events []Event
+}
+
+func (r *replayClock) Now() time.Time {
+	return r.events[min(len(r.game.events), len(r.events)-1)].Time
+}
+
+func (r *replayClock) After(d time.Duration) <-chan time.Time {
+	return SystemClock{}.After(d)
+}
+
+// ReplayEvents rebuilds a game by making the guesses of its events once in order, the events the game emits must be
+// the recorded ones. Step is called after every event when it is not nil.
+func ReplayEvents(events []Event, step func(game *Game, event *Event)) (game *Game, err error) {
+	if len(events) == 0 || events[0].Kind != GameStarted || events[0].Game == nil {
+		return nil, &BadEventError{Message: "events do not start with the game"}
+	}
+
+	game, err = events[0].Game.ToDomain()
+	if err != nil {
+		return nil, fmt.Errorf("start game: %w", err)
+	}
+
+	clock := game.clock
+	game.clock = &replayClock{game: game, events: events}
+
+	game.Start()
+
+	for checked := 0; ; {
+		for ; checked < len(game.events); checked++ {
+			if checked >= len(events) || !game.events[checked].sameAs(&events[checked]) {
+				return nil, &BadEventError{Message: fmt.Sprintf("event %d does not follow from the game", checked)}
+			}
+
+			if step != nil {
+				step(game, &game.events[checked])
+			}
+		}
+
+		if checked == len(events) {
+			game.clock = clock
+			return game, nil
+		}
+
+		guess := events[checked].guess()
+		if guess == nil {
+			return nil, &BadEventError{Message: fmt.Sprintf("event %d (%s) is not a guess", checked, events[checked].Kind)}
+		}
+
+		game.MakeGuess(guess)
+
+		if len(game.events) == checked {
+			return nil, &BadEventError{Message: fmt.Sprintf("event %d does not change the game", checked)}
+		}
+	}
+}
+
+type BadEventError struct {
+	Message string
+}
+
+func (e *BadEventError) Error() string {
+	return fmt.Sprintf("bad event: %s", e.Message)
+}
@@ -0,0 +1,363 @@
+package domain
+
+import (
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+)
+
+// EvilGame does not fix the secret word in advance. After every guess it keeps the largest family of
+// candidate words that are consistent with the revealed pattern, so it commits to a single word only when forced.
+type EvilGame struct {
+	attempts         int
+	mistakes         int
+	maxMistakes      int
+	wordGuessPenalty int
+	alphabet         *Alphabet
+	hintPolicy       HintPolicy
+	requestedHints   int
+	candidates       []Word
+	pattern          []rune
+	used             map[rune]bool
+	usedWords        map[string]bool
+	timedOut         bool
+	// startedAt and finishedAt time the game for the profile, finishedAt is zero until the game is finished.
+	clock      Clock
+	startedAt  time.Time
+	finishedAt time.Time
+}
+
+// NewEvilGame takes the candidates from pool that have the same length and revealed characters as the seed word.
+// Candidates keep their spelling for the pattern, they are grouped by their normalized form.
+// It uses LatinAlphabet when alphabet is nil.
+func NewEvilGame(seed *Word, pool []Word, alphabet *Alphabet, maxMistakes, wordGuessPenalty int) *EvilGame {
+	alphabet = orLatin(alphabet)
+	seedWord := alphabet.NormalizeWord(seed.Word)
+	candidates := make([]Word, 0, len(pool))
+
+	for _, word := range pool {
+		word.Word = alphabet.FoldWord(word.Word)
+		if sameShape(alphabet.NormalizeWord(word.Word), seedWord, alphabet) {
+			candidates = append(candidates, word)
+		}
+	}
+
+	if len(candidates) == 0 {
+		candidates = append(candidates, Word{Word: alphabet.FoldWord(seed.Word), Hint: seed.Hint, MoreHints: seed.MoreHints})
+	}
+
+	pattern := make([]rune, 0, len(seedWord))
+	used := make(map[rune]bool)
+
+	for _, letter := range seedWord {
+		if alphabet.IsRevealed(letter) {
+			pattern = append(pattern, letter)
+			used[letter] = true
+		} else {
+			pattern = append(pattern, HiddenLetter)
+		}
+	}
+
+	return &EvilGame{
+		attempts:         0,
+		mistakes:         0,
+		maxMistakes:      maxMistakes,
+		wordGuessPenalty: wordGuessPenalty,
+		alphabet:         alphabet,
+		hintPolicy:       DefaultHintPolicy,
+		candidates:       candidates,
+		pattern:          pattern,
+		used:             used,
+		usedWords:        make(map[string]bool),
+		clock:            SystemClock{},
+		startedAt:        SystemClock{}.Now().UTC(),
+	}
+}
+
+func sameShape(word, other string, alphabet *Alphabet) bool {
+	wordRunes, otherRunes := []rune(word), []rune(other)
+	if len(wordRunes) != len(otherRunes) {
+		return false
+	}
+
+	for i := range wordRunes {
+		isRevealed := alphabet.IsRevealed(wordRunes[i])
+		if isRevealed != alphabet.IsRevealed(otherRunes[i]) || (isRevealed && wordRunes[i] != otherRunes[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// familyKey marks positions of the letter in the word, words with equal keys reveal the same pattern.
+func familyKey(word string, letter rune) string {
+	var key strings.Builder
+
+	for _, r := range word {
+		if r == letter {
+			key.WriteByte('1')
+		} else {
+			key.WriteByte('0')
+		}
+	}
+
+	return key.String()
+}
+
+func (e *EvilGame) Attempts() int {
+	return e.attempts
+}
+
+func (e *EvilGame) Mistakes() int {
+	return e.mistakes
+}
+
+func (e *EvilGame) MaxMistakes() int {
+	return e.maxMistakes
+}
+
+func (e *EvilGame) State() State {
+	return stateByMistakes(e.mistakes, e.maxMistakes)
+}
+
+func (e *EvilGame) Alphabet() *Alphabet {
+	return e.alphabet
+}
+
+func (e *EvilGame) Used() map[rune]bool {
+	return e.used
+}
+
+// Pattern shows the revealed letters as the first candidate spells them.
+func (e *EvilGame) Pattern() string {
+	spelling := []rune(e.candidates[0].Word)
+	pattern := make([]rune, len(e.pattern))
+
+	for i, letter := range e.pattern {
+		pattern[i] = letter
+		if letter != HiddenLetter {
+			pattern[i] = spelling[i]
+		}
+	}
+
+	return string(pattern)
+}
+
+func (e *EvilGame) Candidates() []Word {
+	return e.candidates
+}
+
+// Word is the first candidate, the game ends on it when the guesser loses.
+func (e *EvilGame) Word() Word {
+	return e.candidates[0]
+}
+
+// Duration is the time from the start of the game to its end, it is zero until the game is finished.
+func (e *EvilGame) Duration() time.Duration {
+	if e.finishedAt.IsZero() {
+		return 0
+	}
+
+	return e.finishedAt.Sub(e.startedAt)
+}
+
+func (e *EvilGame) FinishedAt() time.Time {
+	return e.finishedAt
+}
+
+// MissedLetters returns the used letters that are not in the Word in the alphabet order.
+func (e *EvilGame) MissedLetters() []rune {
+	word := e.alphabet.NormalizeWord(e.Word().Word)
+	missed := make([]rune, 0, e.mistakes)
+
+	for _, letter := range e.alphabet.Letters() {
+		if e.used[letter] && !strings.ContainsRune(word, letter) {
+			missed = append(missed, letter)
+		}
+	}
+
+	return missed
+}
+
+func (e *EvilGame) SetHintPolicy(policy HintPolicy) {
+	e.hintPolicy = policy
+}
+
+// wordHints are known only when the game has committed to a single word.
+func (e *EvilGame) wordHints() []string {
+	if len(e.candidates) != 1 {
+		return nil
+	}
+
+	return e.candidates[0].Hints()
+}
+
+func (e *EvilGame) Hints() []string {
+	return shownHints(e.hintPolicy, e.wordHints(), e.requestedHints, e.mistakes, e.maxMistakes)
+}
+
+func (e *EvilGame) HintRequestCost() (cost int, ok bool) {
+	cost, ok = e.hintPolicy.RequestCost()
+
+	return cost, ok && e.requestedHints < len(e.wordHints()) && !e.IsFinished()
+}
+
+func (e *EvilGame) RequestHint() {
+	cost, ok := e.HintRequestCost()
+
+	slog.Info("Evil hint requested", slog.Bool("available", ok), slog.Int("cost", cost))
+
+	if !ok {
+		return
+	}
+
+	e.requestedHints++
+	e.mistakes = min(e.mistakes+cost, e.maxMistakes)
+}
+
+// PowerUps are not available because the evil game has no fixed word to reveal a letter of.
+func (e *EvilGame) PowerUps() PowerUps {
+	return PowerUps{}
+}
+
+func (e *EvilGame) CanBuyLetter() bool {
+	return false
+}
+
+// CanUndo is false because guesses taken back could have narrowed the candidates.
+func (e *EvilGame) CanUndo() bool {
+	return false
+}
+
+func (e *EvilGame) Guess(letter rune) {
+	letter = e.alphabet.Normalize(letter)
+
+	slog.Info("Evil guess letter", SecretAttr("letter", string(letter)))
+
+	if e.used[letter] || !e.alphabet.IsLetter(letter) {
+		return
+	}
+
+	e.attempts++
+	e.used[letter] = true
+
+	families := make(map[string][]Word)
+	for _, word := range e.candidates {
+		key := familyKey(e.alphabet.NormalizeWord(word.Word), letter)
+		families[key] = append(families[key], word)
+	}
+
+	missKey := strings.Repeat("0", len(e.pattern))
+	bestKey := ""
+
+	keys := make([]string, 0, len(families))
+	for key := range families {
+		keys = append(keys, key)
+	}
+
+	// Sorting makes the choice deterministic, a miss is preferred among families of equal size
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		if bestKey == "" || len(families[key]) > len(families[bestKey]) ||
+			(len(families[key]) == len(families[bestKey]) && key == missKey) {
+			bestKey = key
+		}
+	}
+
+	e.candidates = families[bestKey]
+
+	slog.Info("Evil family chosen", slog.Int("candidates", len(e.candidates)), slog.String("family", bestKey))
+
+	if bestKey == missKey {
+		e.mistakes++
+		return
+	}
+
+	for i, mark := range bestKey {
+		if mark == '1' {
+			e.pattern[i] = letter
+		}
+	}
+}
+
+func (e *EvilGame) GuessWord(word string) {
+	word = e.alphabet.NormalizeGuessWord(word)
+
+	slog.Info("Evil guess word", SecretAttr("word", word))
+
+	if e.usedWords[word] {
+		return
+	}
+
+	e.attempts++
+	e.usedWords[word] = true
+
+	if len(e.candidates) == 1 && e.alphabet.NormalizeGuessWord(e.candidates[0].Word) == word {
+		// The pattern keeps the spaces of the candidate, the guess may have them collapsed
+		e.pattern = []rune(e.alphabet.NormalizeWord(e.candidates[0].Word))
+
+		for _, letter := range e.pattern {
+			e.used[letter] = true
+		}
+
+		return
+	}
+
+	// The guessed word is dropped while there are other candidates left
+	remaining := slices.DeleteFunc(slices.Clone(e.candidates), func(candidate Word) bool {
+		return e.alphabet.NormalizeGuessWord(candidate.Word) == word
+	})
+	if len(remaining) > 0 {
+		e.candidates = remaining
+	}
+
+	e.mistakes = min(e.mistakes+e.wordGuessPenalty, e.maxMistakes)
+}
+
+func (e *EvilGame) MakeGuess(guess *Guess) {
+	switch guess.Kind {
+	case LetterGuess:
+		e.Guess(guess.Letter)
+	case WordGuess:
+		e.GuessWord(guess.Word)
+	case HintRequest:
+		e.RequestHint()
+	case GuessTimeout:
+		e.attempts++
+		e.mistakes = min(e.mistakes+1, e.maxMistakes)
+
+		slog.Info("Evil guess timed out", slog.Int("mistakes", e.mistakes))
+	case GameTimeout:
+		e.timedOut = true
+
+		slog.Info("Evil game timed out")
+	}
+
+	if e.finishedAt.IsZero() && e.IsFinished() {
+		e.finishedAt = e.clock.Now().UTC()
+	}
+}
+
+func (e *EvilGame) IsWin() bool {
+	return !slices.Contains(e.pattern, HiddenLetter)
+}
+
+func (e *EvilGame) IsLose() bool {
+	return e.mistakes >= e.maxMistakes || e.timedOut
+}
+
+func (e *EvilGame) IsFinished() bool {
+	return e.IsLose() || e.IsWin()
+}
+
+func (e *EvilGame) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("attempts", e.attempts),
+		slog.Int("mistakes", e.mistakes),
+		slog.Int("candidates", len(e.candidates)),
+		slog.String("pattern", e.Pattern()),
+	)
+}
@@ -1,41 +1,195 @@
 package domain
 
 import (
+	"fmt"
 	"log/slog"
-	"strings"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"time"
 )
 
+const DefaultWordGuessPenalty = 2
+
+// GameJSON is the state of a game, it is saved to resume the game and starts the events of a recorded game.
+type GameJSON struct {
+	MaxMistakes      int      `json:"maxMistakes"`
+	WordGuessPenalty int      `json:"wordGuessPenalty"`
+	Word             WordJSON `json:"word"`
+	// Games saved without an alphabet are played with LatinAlphabet.
+	Alphabet      *AlphabetJSON      `json:"alphabet,omitempty"`
+	Normalization *NormalizationJSON `json:"normalization,omitempty"`
+	// Games saved without a hint policy use DefaultHintPolicy.
+	HintPolicy     string `json:"hintPolicy,omitempty"`
+	RequestedHints int    `json:"requestedHints"`
+	// LetterPurchases are the power-ups left.
+	LetterPurchases int      `json:"letterPurchases"`
+	LetterCost      int      `json:"letterCost"`
+	BoughtLetters   []string `json:"boughtLetters"`
+	Attempts        int      `json:"attempts"`
+	Mistakes        int      `json:"mistakes"`
+	Used            []string `json:"used"`
+	UsedWords       []string `json:"usedWords"`
+	Practice        bool     `json:"practice,omitempty"`
+}
+
+func (j *GameJSON) ToDomain() (game *Game, err error) {
+	if j.MaxMistakes < 1 || j.Mistakes < 0 || j.Mistakes > j.MaxMistakes || j.Attempts < 0 {
+		return nil, &BadGameError{Message: "inconsistent mistakes or attempts"}
+	}
+
+	alphabet, err := j.Alphabet.ToDomain()
+	if err != nil {
+		return nil, &BadGameError{Message: err.Error()}
+	}
+
+	normalization, err := j.Normalization.ToDomain()
+	if err != nil {
+		return nil, &BadGameError{Message: err.Error()}
+	}
+
+	alphabet = alphabet.WithNormalization(normalization)
+
+	game = NewGame(j.Word.ToDomain(), alphabet, j.MaxMistakes, j.WordGuessPenalty)
+	game.attempts = j.Attempts
+	game.mistakes = j.Mistakes
+	game.practice = j.Practice
+
+	if err := j.restoreProgress(game); err != nil {
+		return nil, err
+	}
+
+	return game, nil
+}
+
+func (j *GameJSON) restoreProgress(game *Game) (err error) {
+	if j.HintPolicy != "" {
+		game.hintPolicy, err = ParseHintPolicy(j.HintPolicy)
+		if err != nil {
+			return &BadGameError{Message: err.Error()}
+		}
+	}
+
+	if j.RequestedHints < 0 || j.RequestedHints > len(game.word.Hints()) {
+		return &BadGameError{Message: "inconsistent requested hints"}
+	}
+
+	game.requestedHints = j.RequestedHints
+
+	if j.LetterPurchases < 0 || j.LetterCost < 0 {
+		return &BadGameError{Message: "inconsistent power-ups"}
+	}
+
+	game.powerUps = PowerUps{Letters: j.LetterPurchases, LetterCost: j.LetterCost}
+
+	for _, letter := range j.Used {
+		runes := []rune(letter)
+		if len(runes) != 1 {
+			return &BadGameError{Message: fmt.Sprintf("used letter %q is not a single letter", letter)}
+		}
+
+		game.used[runes[0]] = true
+	}
+
+	for _, letter := range j.BoughtLetters {
+		runes := []rune(letter)
+		if len(runes) != 1 || !game.used[runes[0]] {
+			return &BadGameError{Message: fmt.Sprintf("bought letter %q is not a used letter", letter)}
+		}
+
+		game.bought[runes[0]] = true
+	}
+
+	for _, word := range j.UsedWords {
+		game.usedWords[word] = true
+	}
+
+	return nil
+}
+
 type Game struct {
+	attempts         int
+	mistakes         int
+	maxMistakes      int
+	wordGuessPenalty int
+	word             Word
+	alphabet         *Alphabet
+	hintPolicy       HintPolicy
+	requestedHints   int
+	powerUps         PowerUps
+	correctLetters   map[rune]bool
+	used             map[rune]bool
+	bought           map[rune]bool
+	usedWords        map[string]bool
+	timedOut         bool
+	practice         bool
+	history          []move
+	clock            Clock
+	// random chooses the bought letters, the global source is used when it is nil.
+	random *rand.Rand
+	events []Event
+	// shownHints is the number of hints the emitted HintShown events are about.
+	shownHints int
+}
+
+// move is a guess made in the game together with the state it changed, so it can be taken back.
+type move struct {
+	guess          Guess
 	attempts       int
 	mistakes       int
-	maxMistakes    int
-	word           Word
-	correctLetters map[rune]bool
-	used           map[rune]bool
+	requestedHints int
+	powerUps       PowerUps
+	timedOut       bool
+	used           []rune
+	bought         []rune
+	word           string
 }
 
-func NewGame(word *Word, maxMistakes int) *Game {
-	word.Word = strings.ToLower(word.Word)
+// NewGame uses LatinAlphabet when alphabet is nil and DefaultHintPolicy until another one is set.
+// The game folds its own copy of the word, so the word chosen from a category is left as it is loaded.
+func NewGame(word *Word, alphabet *Alphabet, maxMistakes, wordGuessPenalty int) *Game {
+	alphabet = orLatin(alphabet)
+	folded := *word
+	folded.Word = alphabet.FoldWord(word.Word)
+	word = &folded
 
 	correctLetters := make(map[rune]bool)
 	used := make(map[rune]bool)
 
-	for _, letter := range word.Word {
+	for _, letter := range alphabet.NormalizeWord(word.Word) {
 		correctLetters[letter] = true
 
-		if letter == ' ' {
+		if alphabet.IsRevealed(letter) {
 			used[letter] = true
 		}
 	}
 
 	return &Game{
-		attempts:       0,
-		mistakes:       0,
-		maxMistakes:    maxMistakes,
-		word:           *word,
-		correctLetters: correctLetters,
-		used:           used,
+		attempts:         0,
+		mistakes:         0,
+		maxMistakes:      maxMistakes,
+		wordGuessPenalty: wordGuessPenalty,
+		word:             *word,
+		alphabet:         alphabet,
+		hintPolicy:       DefaultHintPolicy,
+		correctLetters:   correctLetters,
+		used:             used,
+		bought:           make(map[rune]bool),
+		usedWords:        make(map[string]bool),
+		clock:            SystemClock{},
+	}
+}
+
+func (g *Game) wrongLetters() int {
+	wrong := 0
+
+	for _, letter := range g.alphabet.Letters() {
+		if !g.correctLetters[letter] {
+			wrong++
+		}
 	}
+
+	return wrong
 }
 
 func (g *Game) Attempts() int {
@@ -51,23 +205,11 @@ func (g *Game) MaxMistakes() int {
 }
 
 func (g *Game) State() State {
-	prop := g.mistakes * StateCount / g.maxMistakes
-	switch prop {
-	case 1:
-		return Head
-	case 2:
-		return Body
-	case 3:
-		return LeftArm
-	case 4:
-		return RightArm
-	case 5:
-		return LeftLeg
-	case 6:
-		return RightLeg
-	default:
-		return Initial
-	}
+	return stateByMistakes(g.mistakes, g.maxMistakes)
+}
+
+func (g *Game) Alphabet() *Alphabet {
+	return g.alphabet
 }
 
 func (g *Game) Used() map[rune]bool {
@@ -78,10 +220,10 @@ func (g *Game) Pattern() string {
 	pattern := ""
 
 	for _, letter := range g.word.Word {
-		if g.used[letter] && g.correctLetters[letter] {
+		if normalized := g.alphabet.Normalize(letter); g.used[normalized] && g.correctLetters[normalized] {
 			pattern += string(letter)
 		} else {
-			pattern += "_"
+			pattern += string(HiddenLetter)
 		}
 	}
 
@@ -90,14 +232,99 @@ func (g *Game) Pattern() string {
 	return pattern
 }
 
-func (g *Game) Hint() string {
-	return g.word.Hint
+func (g *Game) SetHintPolicy(policy HintPolicy) {
+	g.hintPolicy = policy
+}
+
+func (g *Game) HintPolicy() HintPolicy {
+	return g.hintPolicy
+}
+
+func (g *Game) Hints() []string {
+	return shownHints(g.hintPolicy, g.word.Hints(), g.requestedHints, g.mistakes, g.maxMistakes)
+}
+
+// HintRequestCost returns the mistakes the next hint costs, ok is false when no more hints can be requested.
+func (g *Game) HintRequestCost() (cost int, ok bool) {
+	cost, ok = g.hintPolicy.RequestCost()
+
+	return cost, ok && g.requestedHints < len(g.word.Hints()) && !g.IsFinished()
+}
+
+func (g *Game) RequestHint() {
+	cost, ok := g.HintRequestCost()
+
+	slog.Info("Hint requested", slog.Bool("available", ok), slog.Int("cost", cost))
+
+	if !ok {
+		return
+	}
+
+	g.requestedHints++
+	g.mistakes = min(g.mistakes+cost, g.maxMistakes)
+}
+
+func (g *Game) SetPowerUps(powerUps PowerUps) {
+	g.powerUps = powerUps
+}
+
+// PowerUps returns the power-ups left.
+func (g *Game) PowerUps() PowerUps {
+	return g.powerUps
+}
+
+// CanBuyLetter is false when there are no letters left to buy or the cost would lose the game.
+func (g *Game) CanBuyLetter() bool {
+	return g.powerUps.Letters > 0 && g.mistakes+g.powerUps.LetterCost < g.maxMistakes && !g.IsFinished()
+}
+
+// BuyLetter reveals a random letter of the word that is not used yet.
+func (g *Game) BuyLetter() {
+	if !g.CanBuyLetter() {
+		slog.Info("Letter can not be bought", slog.Any("power-ups", g.powerUps))
+		return
+	}
+
+	hidden := make([]rune, 0, len(g.correctLetters))
+
+	for letter := range g.correctLetters {
+		if !g.used[letter] && g.alphabet.IsLetter(letter) {
+			hidden = append(hidden, letter)
+		}
+	}
+
+	// Sorting keeps the choice independent of the map order
+	slices.Sort(hidden)
+
+	index := rand.IntN
+	if g.random != nil {
+		index = g.random.IntN
+	}
+
+	g.buyLetter(hidden[index(len(hidden))])
+}
+
+// buyLetter reveals the given letter, so that replayed games buy the recorded letters.
+func (g *Game) buyLetter(letter rune) {
+	if !g.CanBuyLetter() || g.used[letter] || !g.correctLetters[letter] || !g.alphabet.IsLetter(letter) {
+		slog.Info("Letter can not be bought", SecretAttr("letter", string(letter)), slog.Any("power-ups", g.powerUps))
+		return
+	}
+
+	g.powerUps.Letters--
+	g.used[letter] = true
+	g.bought[letter] = true
+	g.mistakes += g.powerUps.LetterCost
+
+	slog.Info("Letter bought", SecretAttr("letter", string(letter)), slog.Any("power-ups", g.powerUps))
 }
 
 func (g *Game) Guess(letter rune) {
-	slog.Info("Guess letter", slog.String("letter", string(letter)))
+	letter = g.alphabet.Normalize(letter)
+
+	slog.Info("Guess letter", SecretAttr("letter", string(letter)))
 
-	if g.used[letter] || letter == ' ' {
+	if g.used[letter] || !g.alphabet.IsLetter(letter) {
 		return
 	}
 
@@ -105,17 +332,281 @@ func (g *Game) Guess(letter rune) {
 	g.used[letter] = true
 
 	if g.correctLetters[letter] {
-		slog.Info("Correct guess", slog.String("letter", string(letter)))
+		slog.Info("Correct guess", SecretAttr("letter", string(letter)))
 		return
 	}
 
-	slog.Info("Incorrect guess", slog.String("letter", string(letter)))
+	slog.Info("Incorrect guess", SecretAttr("letter", string(letter)))
 
 	g.mistakes++
 }
 
+func (g *Game) GuessWord(word string) {
+	word = g.alphabet.NormalizeGuessWord(word)
+
+	slog.Info("Guess word", SecretAttr("word", word))
+
+	if g.usedWords[word] {
+		return
+	}
+
+	g.attempts++
+	g.usedWords[word] = true
+
+	if word == g.alphabet.NormalizeGuessWord(g.word.Word) {
+		slog.Info("Correct word guess", SecretAttr("word", word))
+
+		for letter := range g.correctLetters {
+			g.used[letter] = true
+		}
+
+		return
+	}
+
+	slog.Info("Incorrect word guess", SecretAttr("word", word), slog.Int("penalty", g.wordGuessPenalty))
+
+	g.mistakes = min(g.mistakes+g.wordGuessPenalty, g.maxMistakes)
+}
+
+// MakeGuess starts the game when it is not started yet and emits the events of the guess.
+func (g *Game) MakeGuess(guess *Guess) {
+	g.Start()
+
+	finished := g.IsFinished()
+
+	if guess.Kind == UndoRequest {
+		g.Undo()
+	} else {
+		g.play(guess)
+	}
+
+	g.emitFollowing(finished)
+}
+
+func (g *Game) play(guess *Guess) {
+	before := &move{
+		guess:          *guess,
+		attempts:       g.attempts,
+		mistakes:       g.mistakes,
+		requestedHints: g.requestedHints,
+		powerUps:       g.powerUps,
+		timedOut:       g.timedOut,
+	}
+	used, bought, usedWords := maps.Clone(g.used), maps.Clone(g.bought), len(g.usedWords)
+
+	defer g.record(before, used, bought, usedWords)
+
+	switch guess.Kind {
+	case LetterGuess:
+		g.Guess(guess.Letter)
+	case WordGuess:
+		g.GuessWord(guess.Word)
+	case HintRequest:
+		g.RequestHint()
+	case LetterPurchase:
+		if guess.Letter != 0 {
+			g.buyLetter(guess.Letter)
+		} else {
+			g.BuyLetter()
+		}
+	case GuessTimeout:
+		g.attempts++
+		g.mistakes = min(g.mistakes+1, g.maxMistakes)
+
+		slog.Info("Guess timed out", slog.Int("mistakes", g.mistakes))
+	case GameTimeout:
+		g.timedOut = true
+
+		slog.Info("Game timed out")
+	}
+}
+
+// SetRandom makes the bought letters reproducible by the seed of the session.
+func (g *Game) SetRandom(random *rand.Rand) {
+	g.random = random
+}
+
+// SetPractice allows taking guesses back, it must stay off in scored and competitive modes.
+func (g *Game) SetPractice(practice bool) {
+	g.practice = practice
+}
+
+func (g *Game) IsPractice() bool {
+	return g.practice
+}
+
+// History returns the guesses that changed the game with normalized letters and words, taken back guesses are not included.
+func (g *Game) History() []Guess {
+	guesses := make([]Guess, 0, len(g.history))
+	for _, move := range g.history {
+		guesses = append(guesses, move.guess)
+	}
+
+	return guesses
+}
+
+func (g *Game) CanUndo() bool {
+	return g.practice && len(g.history) > 0 && !g.IsFinished()
+}
+
+// Undo takes back the last guess and restores attempts, mistakes, hints, power-ups and used letters.
+func (g *Game) Undo() {
+	if !g.CanUndo() {
+		slog.Info("Guess can not be taken back", slog.Bool("practice", g.practice), slog.Int("history", len(g.history)))
+		return
+	}
+
+	last := g.history[len(g.history)-1]
+	g.history = g.history[:len(g.history)-1]
+
+	g.attempts = last.attempts
+	g.mistakes = last.mistakes
+	g.requestedHints = last.requestedHints
+	g.powerUps = last.powerUps
+	g.timedOut = last.timedOut
+
+	for _, letter := range last.used {
+		delete(g.used, letter)
+	}
+
+	for _, letter := range last.bought {
+		delete(g.bought, letter)
+	}
+
+	if last.word != "" {
+		delete(g.usedWords, last.word)
+	}
+
+	g.emit(Event{Kind: GuessTakenBack})
+
+	slog.Info("Guess taken back", slog.Any("guess", &last.guess), slog.Any("game", g))
+}
+
+// record adds the guess to the history when it changed the game, before is the game state before the guess.
+func (g *Game) record(before *move, used, bought map[rune]bool, usedWords int) {
+	for letter := range g.used {
+		if !used[letter] {
+			before.used = append(before.used, letter)
+		}
+	}
+
+	for letter := range g.bought {
+		if !bought[letter] {
+			before.bought = append(before.bought, letter)
+		}
+	}
+
+	if len(g.usedWords) != usedWords {
+		before.word = g.alphabet.NormalizeGuessWord(before.guess.Word)
+		before.guess.Word = before.word
+	}
+
+	// The history keeps guesses as they are applied, so replayed games have the same history
+	if before.guess.Kind == LetterGuess {
+		before.guess.Letter = g.alphabet.Normalize(before.guess.Letter)
+	}
+
+	changed := len(before.used) > 0 || before.word != "" || before.attempts != g.attempts || before.mistakes != g.mistakes ||
+		before.requestedHints != g.requestedHints || before.powerUps != g.powerUps || before.timedOut != g.timedOut
+	if changed {
+		g.history = append(g.history, *before)
+		g.emit(g.guessEvent(before))
+	}
+}
+
+// guessEvent describes the guess of the move that changed the game.
+func (g *Game) guessEvent(move *move) Event {
+	switch move.guess.Kind {
+	case LetterGuess:
+		return Event{Kind: LetterGuessed, Letter: move.guess.Letter, Hit: g.correctLetters[move.guess.Letter]}
+	case WordGuess:
+		return Event{Kind: WordGuessed, Word: move.word, Hit: move.word == g.alphabet.NormalizeGuessWord(g.word.Word)}
+	case HintRequest:
+		return Event{Kind: HintRequested}
+	case LetterPurchase:
+		return Event{Kind: LetterBought, Letter: move.bought[0]}
+	case GuessTimeout:
+		return Event{Kind: GuessTimedOut}
+	default:
+		return Event{Kind: GameTimedOut}
+	}
+}
+
+// Start emits GameStarted with the state the game is set up with, it does nothing when the game is started.
+func (g *Game) Start() {
+	if len(g.events) > 0 {
+		return
+	}
+
+	g.emit(Event{Kind: GameStarted, Game: g.ToJSON()})
+	g.shownHints = 0
+	g.emitFollowing(false)
+}
+
+// Events returns the events emitted since the game started.
+func (g *Game) Events() []Event {
+	return g.events
+}
+
+// Duration is the time from the start of the game to its last event.
+func (g *Game) Duration() time.Duration {
+	if len(g.events) == 0 {
+		return 0
+	}
+
+	return g.events[len(g.events)-1].Time.Sub(g.events[0].Time)
+}
+
+// FinishedAt is the time of the last event, it is zero when the game is not started.
+func (g *Game) FinishedAt() time.Time {
+	if len(g.events) == 0 {
+		return time.Time{}
+	}
+
+	return g.events[len(g.events)-1].Time
+}
+
+// MissedLetters returns the used letters that are not in the word in the alphabet order.
+func (g *Game) MissedLetters() []rune {
+	missed := make([]rune, 0, g.mistakes)
+
+	for _, letter := range g.alphabet.Letters() {
+		if g.used[letter] && !g.correctLetters[letter] {
+			missed = append(missed, letter)
+		}
+	}
+
+	return missed
+}
+
+func (g *Game) emit(event Event) {
+	event.Time = g.clock.Now().UTC()
+	g.events = append(g.events, event)
+
+	slog.Info("Game event emitted", slog.Any("event", &event))
+}
+
+// emitFollowing emits the hints shown and the end of the game that follow from the last guess.
+func (g *Game) emitFollowing(finished bool) {
+	hints := g.Hints()
+
+	for _, hint := range hints[min(g.shownHints, len(hints)):] {
+		g.emit(Event{Kind: HintShown, Hint: hint})
+	}
+
+	g.shownHints = len(hints)
+
+	switch {
+	case finished:
+	case g.IsWin():
+		g.emit(Event{Kind: GameWon})
+	case g.IsLose():
+		g.emit(Event{Kind: GameLost})
+	}
+}
+
 func (g *Game) IsWin() bool {
-	for _, letter := range g.word.Word {
+	for _, letter := range g.alphabet.NormalizeWord(g.word.Word) {
 		if !g.used[letter] {
 			return false
 		}
@@ -127,21 +618,69 @@ func (g *Game) IsWin() bool {
 }
 
 func (g *Game) IsLose() bool {
-	return g.mistakes >= g.maxMistakes
+	return g.mistakes >= g.maxMistakes || g.timedOut
 }
 
 func (g *Game) IsFinished() bool {
 	return g.IsLose() || g.IsWin()
 }
 
-func (g *Game) IsHintAvailable() bool {
-	return g.mistakes >= g.maxMistakes/2
+func (g *Game) ToJSON() *GameJSON {
+	usedWords := make([]string, 0, len(g.usedWords))
+	for word := range g.usedWords {
+		usedWords = append(usedWords, word)
+	}
+
+	slices.Sort(usedWords)
+
+	return &GameJSON{
+		MaxMistakes:      g.maxMistakes,
+		WordGuessPenalty: g.wordGuessPenalty,
+		Word:             g.word.ToJSON(),
+		Alphabet:         g.alphabet.ToJSON(),
+		Normalization:    g.alphabet.Normalization().ToJSON(),
+		HintPolicy:       g.hintPolicy.String(),
+		RequestedHints:   g.requestedHints,
+		LetterPurchases:  g.powerUps.Letters,
+		LetterCost:       g.powerUps.LetterCost,
+		BoughtLetters:    sortedLetters(g.bought),
+		Attempts:         g.attempts,
+		Mistakes:         g.mistakes,
+		Used:             sortedLetters(g.used),
+		UsedWords:        usedWords,
+		Practice:         g.practice,
+	}
+}
+
+func sortedLetters(letters map[rune]bool) []string {
+	sorted := make([]string, 0, len(letters))
+	for letter := range letters {
+		sorted = append(sorted, string(letter))
+	}
+
+	slices.Sort(sorted)
+
+	return sorted
 }
 
 func (g *Game) LogValue() slog.Value {
 	return slog.GroupValue(
 		slog.Int("attempts", g.attempts),
 		slog.Int("mistakes", g.mistakes),
-		slog.String("word", g.word.Word),
+		SecretAttr("word", g.word.Word),
+		SecretAttr("hint", g.word.Hint),
+		slog.String("hintPolicy", g.hintPolicy.String()),
+		slog.Int("requestedHints", g.requestedHints),
+		slog.Any("powerUps", g.powerUps),
+		slog.Bool("practice", g.practice),
+		slog.Int("history", len(g.history)),
 	)
 }
+
+type BadGameError struct {
+	Message string
+}
+
+func (e *BadGameError) Error() string {
+	return fmt.Sprintf("bad game: %s", e.Message)
+}
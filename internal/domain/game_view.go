@@ -0,0 +1,25 @@
+package domain
+
+type GameView interface {
+	Attempts() int
+	Mistakes() int
+	MaxMistakes() int
+	State() State
+	Alphabet() *Alphabet
+	Used() map[rune]bool
+	Pattern() string
+	// Hints are the hints shown to the player, ordered from vague to specific.
+	Hints() []string
+	HintRequestCost() (cost int, ok bool)
+	PowerUps() PowerUps
+	CanBuyLetter() bool
+	CanUndo() bool
+	IsWin() bool
+	IsLose() bool
+	IsFinished() bool
+}
+
+type GameEngine interface {
+	GameView
+	MakeGuess(guess *Guess)
+}
@@ -0,0 +1,66 @@
+package domain
+
+import "log/slog"
+
+type GuessKind int
+
+const (
+	LetterGuess GuessKind = iota
+	WordGuess
+	// HintRequest asks for the next hint instead of guessing.
+	HintRequest
+	// LetterPurchase spends a power-up to reveal a letter instead of guessing.
+	LetterPurchase
+	// GuessTimeout is made when the time for a guess runs out, it counts as a mistake.
+	GuessTimeout
+	// GameTimeout is made when the time for the whole game runs out, it loses the game.
+	GameTimeout
+	// UndoRequest takes back the last guess in practice games.
+	UndoRequest
+)
+
+func (k GuessKind) String() string {
+	return [...]string{"Letter", "Word", "Hint", "Purchase", "GuessTimeout", "GameTimeout", "Undo"}[k]
+}
+
+type Guess struct {
+	Kind   GuessKind
+	Letter rune
+	Word   string
+}
+
+func NewLetterGuess(letter rune) *Guess {
+	return &Guess{Kind: LetterGuess, Letter: letter}
+}
+
+func NewWordGuess(word string) *Guess {
+	return &Guess{Kind: WordGuess, Word: word}
+}
+
+func NewHintRequest() *Guess {
+	return &Guess{Kind: HintRequest}
+}
+
+func NewLetterPurchase() *Guess {
+	return &Guess{Kind: LetterPurchase}
+}
+
+func NewGuessTimeout() *Guess {
+	return &Guess{Kind: GuessTimeout}
+}
+
+func NewGameTimeout() *Guess {
+	return &Guess{Kind: GameTimeout}
+}
+
+func NewUndoRequest() *Guess {
+	return &Guess{Kind: UndoRequest}
+}
+
+func (g *Guess) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("kind", g.Kind.String()),
+		SecretAttr("letter", string(g.Letter)),
+		SecretAttr("word", g.Word),
+	)
+}
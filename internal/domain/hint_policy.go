@@ -0,0 +1,217 @@
+package domain
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+)
+
+// HintProgress is what a hint policy decides on.
+type HintProgress struct {
+	Hints       int
+	Requested   int
+	Mistakes    int
+	MaxMistakes int
+}
+
+// HintPolicy decides how many hints of the word are shown, hints are ordered from vague to specific.
+type HintPolicy interface {
+	Shown(progress HintProgress) int
+	// RequestCost returns the mistakes a requested hint costs, ok is false when hints are not given on request.
+	RequestCost() (cost int, ok bool)
+	// String returns the policy in the form accepted by ParseHintPolicy.
+	String() string
+}
+
+type NeverHints struct{}
+
+func (NeverHints) Shown(HintProgress) int {
+	return 0
+}
+
+func (NeverHints) RequestCost() (cost int, ok bool) {
+	return 0, false
+}
+
+func (NeverHints) String() string {
+	return "never"
+}
+
+type AlwaysHints struct{}
+
+func (AlwaysHints) Shown(progress HintProgress) int {
+	return progress.Hints
+}
+
+func (AlwaysHints) RequestCost() (cost int, ok bool) {
+	return 0, false
+}
+
+func (AlwaysHints) String() string {
+	return "always"
+}
+
+// HalfMistakesHints shows the hints once half of the mistakes are made.
+type HalfMistakesHints struct{}
+
+func (HalfMistakesHints) Shown(progress HintProgress) int {
+	if progress.Mistakes >= progress.MaxMistakes/2 {
+		return progress.Hints
+	}
+
+	return 0
+}
+
+func (HalfMistakesHints) RequestCost() (cost int, ok bool) {
+	return 0, false
+}
+
+func (HalfMistakesHints) String() string {
+	return "half"
+}
+
+type AfterMistakesHints struct {
+	Mistakes int
+}
+
+func (a AfterMistakesHints) Shown(progress HintProgress) int {
+	if progress.Mistakes >= a.Mistakes {
+		return progress.Hints
+	}
+
+	return 0
+}
+
+func (AfterMistakesHints) RequestCost() (cost int, ok bool) {
+	return 0, false
+}
+
+func (a AfterMistakesHints) String() string {
+	return fmt.Sprintf("after:%d", a.Mistakes)
+}
+
+// OnRequestHints shows the next hint every time the player asks for it and pays Cost mistakes.
+type OnRequestHints struct {
+	Cost int
+}
+
+func (OnRequestHints) Shown(progress HintProgress) int {
+	return min(progress.Requested, progress.Hints)
+}
+
+func (o OnRequestHints) RequestCost() (cost int, ok bool) {
+	return o.Cost, true
+}
+
+func (o OnRequestHints) String() string {
+	return fmt.Sprintf("request:%d", o.Cost)
+}
+
+// ProgressiveHints spreads the hints evenly over the mistakes, so the more mistakes the more specific hints are shown.
+type ProgressiveHints struct{}
+
+func (ProgressiveHints) Shown(progress HintProgress) int {
+	if progress.MaxMistakes < 1 {
+		return progress.Hints
+	}
+
+	return min(progress.Hints, progress.Mistakes*(progress.Hints+1)/progress.MaxMistakes)
+}
+
+func (ProgressiveHints) RequestCost() (cost int, ok bool) {
+	return 0, false
+}
+
+func (ProgressiveHints) String() string {
+	return "progressive"
+}
+
+// DefaultHintPolicy is used by games created or saved without a policy.
+var DefaultHintPolicy HintPolicy = HalfMistakesHints{}
+
+// ParseHintPolicy accepts never, always, half, after:N, request[:N] and progressive.
+func ParseHintPolicy(value string) (policy HintPolicy, err error) {
+	name, arg, hasArg := strings.Cut(strings.ToLower(strings.TrimSpace(value)), ":")
+
+	number := 1
+
+	if hasArg {
+		number, err = strconv.Atoi(arg)
+		if err != nil || number < 0 {
+			return nil, &BadHintPolicyError{Message: fmt.Sprintf("%q is not a number of mistakes", arg)}
+		}
+	}
+
+	switch {
+	case name == "never" && !hasArg:
+		return NeverHints{}, nil
+	case name == "always" && !hasArg:
+		return AlwaysHints{}, nil
+	case name == "half" && !hasArg:
+		return HalfMistakesHints{}, nil
+	case name == "after" && hasArg:
+		return AfterMistakesHints{Mistakes: number}, nil
+	case name == "request":
+		return OnRequestHints{Cost: number}, nil
+	case name == "progressive" && !hasArg:
+		return ProgressiveHints{}, nil
+	default:
+		return nil, &BadHintPolicyError{Message: fmt.Sprintf("unknown policy %q", value)}
+	}
+}
+
+func shownHints(policy HintPolicy, hints []string, requested, mistakes, maxMistakes int) []string {
+	shown := policy.Shown(HintProgress{Hints: len(hints), Requested: requested, Mistakes: mistakes, MaxMistakes: maxMistakes})
+
+	return hints[:max(0, min(shown, len(hints)))]
+}
+
+// HintPolicies choose the hint policy of a game by the difficulty of its word.
+type HintPolicies struct {
+	Default      HintPolicy
+	ByDifficulty map[Difficulty]HintPolicy
+	// Levels name the difficulties in logs, empty levels are DefaultDifficultyLevels.
+	Levels DifficultyLevels
+}
+
+// For falls back to DefaultHintPolicy when policies are nil or have no default.
+func (h *HintPolicies) For(difficulty Difficulty) HintPolicy {
+	if h == nil {
+		return DefaultHintPolicy
+	}
+
+	if policy, ok := h.ByDifficulty[difficulty]; ok {
+		return policy
+	}
+
+	if h.Default == nil {
+		return DefaultHintPolicy
+	}
+
+	return h.Default
+}
+
+func (h *HintPolicies) LogValue() slog.Value {
+	attrs := []slog.Attr{slog.String("default", h.For(UnknownDifficulty).String())}
+
+	if h == nil {
+		return slog.GroupValue(attrs...)
+	}
+
+	for level := range h.Levels.orDefault() {
+		if policy, ok := h.ByDifficulty[Difficulty(level)]; ok {
+			attrs = append(attrs, slog.String(h.Levels.Name(Difficulty(level)), policy.String()))
+		}
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+type BadHintPolicyError struct {
+	Message string
+}
+
+func (e *BadHintPolicyError) Error() string {
+	return fmt.Sprintf("bad hint policy: %s", e.Message)
+}
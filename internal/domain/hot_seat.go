@@ -0,0 +1,251 @@
+package domain
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+const (
+	MinPlayers = 2
+	MaxPlayers = 8
+)
+
+type MistakesRule int
+
+const (
+	SharedMistakes MistakesRule = iota
+	PersonalMistakes
+)
+
+func (r MistakesRule) String() string {
+	return [...]string{"Shared", "Personal"}[r]
+}
+
+func (r *MistakesRule) Set(value string) error {
+	switch value {
+	case "shared":
+		*r = SharedMistakes
+	case "personal":
+		*r = PersonalMistakes
+	default:
+		return fmt.Errorf("unknown mistakes rule %q", value)
+	}
+
+	return nil
+}
+
+type Player struct {
+	name       string
+	attempts   int
+	mistakes   int
+	eliminated bool
+	// missed are the wrong letters named by the player.
+	missed []rune
+}
+
+func (p *Player) Name() string {
+	return p.name
+}
+
+func (p *Player) Attempts() int {
+	return p.attempts
+}
+
+func (p *Player) Mistakes() int {
+	return p.mistakes
+}
+
+func (p *Player) IsEliminated() bool {
+	return p.eliminated
+}
+
+func (p *Player) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("name", p.name),
+		slog.Int("attempts", p.attempts),
+		slog.Int("mistakes", p.mistakes),
+		slog.Bool("eliminated", p.eliminated),
+	)
+}
+
+type HotSeatGame struct {
+	game        *Game
+	players     []Player
+	rule        MistakesRule
+	maxMistakes int
+	turn        int
+	winner      int
+}
+
+func NewHotSeatGame(
+	word *Word,
+	alphabet *Alphabet,
+	maxMistakes, wordGuessPenalty int,
+	names []string,
+	rule MistakesRule,
+) (*HotSeatGame, error) {
+	if len(names) < MinPlayers || len(names) > MaxPlayers {
+		return nil, &BadPlayersError{Message: fmt.Sprintf("number of players must be from %d to %d", MinPlayers, MaxPlayers)}
+	}
+
+	players := make([]Player, 0, len(names))
+	seen := make(map[string]bool)
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			return nil, &BadPlayersError{Message: fmt.Sprintf("player name %q is empty or repeated", name)}
+		}
+
+		seen[name] = true
+
+		players = append(players, Player{name: name})
+	}
+
+	game := NewGame(word, alphabet, maxMistakes, wordGuessPenalty)
+
+	// Personal budgets are cut to share the wrong letters, otherwise a large group could never be eliminated
+	if rule == PersonalMistakes {
+		maxMistakes = max(1, min(maxMistakes, game.wrongLetters()/len(players)))
+		game.maxMistakes = maxMistakes * len(players)
+	}
+
+	return &HotSeatGame{
+		game:        game,
+		players:     players,
+		rule:        rule,
+		maxMistakes: maxMistakes,
+		turn:        0,
+		winner:      -1,
+	}, nil
+}
+
+func (h *HotSeatGame) Game() *Game {
+	return h.game
+}
+
+func (h *HotSeatGame) Players() []Player {
+	return h.players
+}
+
+func (h *HotSeatGame) Rule() MistakesRule {
+	return h.rule
+}
+
+func (h *HotSeatGame) PlayerMaxMistakes() int {
+	return h.maxMistakes
+}
+
+func (h *HotSeatGame) CurrentPlayer() *Player {
+	return &h.players[h.turn]
+}
+
+func (h *HotSeatGame) Winner() *Player {
+	if h.winner < 0 {
+		return nil
+	}
+
+	return &h.players[h.winner]
+}
+
+func (h *HotSeatGame) Guess(letter rune) {
+	h.MakeGuess(NewLetterGuess(letter))
+}
+
+// MakeGuess plays the guess of the current player, hints and bought letters cost their mistakes but keep the turn.
+func (h *HotSeatGame) MakeGuess(guess *Guess) {
+	// Undo would give back a turn that the next player may have already seen
+	if guess.Kind == UndoRequest {
+		slog.Info("Undo is not allowed in hot seat games")
+		return
+	}
+
+	player := h.CurrentPlayer()
+	attempts, mistakes := h.game.Attempts(), h.game.Mistakes()
+
+	h.game.MakeGuess(guess)
+
+	if guess.Kind == LetterGuess && h.game.Mistakes() > mistakes {
+		player.missed = append(player.missed, h.game.alphabet.Normalize(guess.Letter))
+	}
+
+	h.charge(player, h.game.Mistakes()-mistakes)
+
+	if h.game.Attempts() > attempts {
+		player.attempts++
+	}
+
+	if h.game.IsWin() {
+		h.winner = h.turn
+
+		slog.Info("Player revealed the last letter", slog.Any("player", player))
+
+		return
+	}
+
+	// Already used letters, hints and bought letters do not cost a turn
+	if h.game.Attempts() == attempts && !player.eliminated {
+		return
+	}
+
+	h.nextTurn()
+}
+
+// charge adds the mistakes to the player, a personal budget is never overspent, so the shared game is lost with the last player.
+func (h *HotSeatGame) charge(player *Player, mistakes int) {
+	if h.rule == PersonalMistakes {
+		excess := max(0, player.mistakes+mistakes-h.maxMistakes)
+		mistakes -= excess
+		h.game.mistakes -= excess
+	}
+
+	player.mistakes += mistakes
+
+	if h.rule == PersonalMistakes && mistakes > 0 && player.mistakes >= h.maxMistakes {
+		player.eliminated = true
+
+		slog.Info("Player eliminated", slog.Any("player", player))
+	}
+}
+
+func (h *HotSeatGame) nextTurn() {
+	for i := 1; i <= len(h.players); i++ {
+		next := (h.turn + i) % len(h.players)
+		if !h.players[next].eliminated {
+			h.turn = next
+			return
+		}
+	}
+}
+
+func (h *HotSeatGame) allEliminated() bool {
+	for i := range h.players {
+		if !h.players[i].eliminated {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (h *HotSeatGame) IsFinished() bool {
+	return h.game.IsFinished() || h.allEliminated()
+}
+
+func (h *HotSeatGame) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("rule", h.rule.String()),
+		slog.Int("players", len(h.players)),
+		slog.String("turn", h.CurrentPlayer().name),
+		slog.Any("game", h.game),
+	)
+}
+
+type BadPlayersError struct {
+	Message string
+}
+
+func (e *BadPlayersError) Error() string {
+	return fmt.Sprintf("bad players: %s", e.Message)
+}
@@ -1,7 +1,18 @@
 package domain
 
+import "context"
+
 type GameInputer interface {
 	GetLetter() (letter rune, err error)
+	GetGuess() (guess *Guess, err error)
+	WaitContinue() (err error)
+}
+
+// ContextGameInputer can stop waiting for a guess, so timed games end a turn as soon as the time is over.
+type ContextGameInputer interface {
+	GameInputer
+	// GetGuessContext stops waiting for the guess and returns the cause of ctx when it is done.
+	GetGuessContext(ctx context.Context) (guess *Guess, err error)
 }
 
 type InputerError struct {
@@ -0,0 +1,148 @@
+package domain
+
+import "log/slog"
+
+const (
+	LetterScore          = 10
+	RemainingMistakesMax = 100
+)
+
+type RoundResult struct {
+	Round       int
+	Category    string
+	Difficulty  Difficulty
+	Word        string
+	Win         bool
+	Mistakes    int
+	MaxMistakes int
+	Score       int
+}
+
+func NewRoundResult(round int, category string, difficulty Difficulty, game *Game) *RoundResult {
+	return &RoundResult{
+		Round:       round,
+		Category:    category,
+		Difficulty:  difficulty,
+		Word:        game.word.Word,
+		Win:         game.IsWin(),
+		Mistakes:    game.mistakes,
+		MaxMistakes: game.maxMistakes,
+		Score:       RoundScore(game, difficulty),
+	}
+}
+
+func (r *RoundResult) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("round", r.Round),
+		slog.String("category", r.Category),
+		slog.String("difficulty", r.Difficulty.String()),
+		slog.Bool("win", r.Win),
+		slog.Int("mistakes", r.Mistakes),
+		slog.Int("score", r.Score),
+	)
+}
+
+// RoundScore rewards every letter of the word that was not bought and the share of mistakes left unused,
+// multiplied by the difficulty level. Lost rounds score nothing.
+func RoundScore(game *Game, difficulty Difficulty) int {
+	if !game.IsWin() {
+		return 0
+	}
+
+	letters := 0
+
+	for _, letter := range game.alphabet.NormalizeWord(game.word.Word) {
+		if game.alphabet.IsLetter(letter) && !game.bought[letter] {
+			letters++
+		}
+	}
+
+	remaining := (game.maxMistakes - game.mistakes) * RemainingMistakesMax / game.maxMistakes
+
+	return (letters*LetterScore + remaining) * (int(difficulty) + 1)
+}
+
+type Match struct {
+	rounds  int
+	levels  DifficultyLevels
+	results []RoundResult
+	// adaptive is nil when the difficulty of the rounds does not follow the results.
+	adaptive *AdaptiveLevel
+}
+
+// NewMatch takes the levels of the collection to name the difficulties of the rounds.
+func NewMatch(rounds int, levels DifficultyLevels) *Match {
+	return &Match{
+		rounds:  rounds,
+		levels:  levels,
+		results: make([]RoundResult, 0, rounds),
+	}
+}
+
+func (m *Match) Rounds() int {
+	return m.rounds
+}
+
+func (m *Match) Levels() DifficultyLevels {
+	return m.levels
+}
+
+func (m *Match) CurrentRound() int {
+	return len(m.results) + 1
+}
+
+func (m *Match) AddResult(result *RoundResult) {
+	m.results = append(m.results, *result)
+
+	if m.adaptive != nil {
+		m.adaptive.AddResult(result)
+	}
+}
+
+func (m *Match) SetAdaptiveLevel(adaptive *AdaptiveLevel) {
+	m.adaptive = adaptive
+}
+
+// AdaptiveLevel returns nil when the match is not adaptive.
+func (m *Match) AdaptiveLevel() *AdaptiveLevel {
+	return m.adaptive
+}
+
+func (m *Match) Results() []RoundResult {
+	return m.results
+}
+
+func (m *Match) TotalScore() int {
+	total := 0
+
+	for _, result := range m.results {
+		total += result.Score
+	}
+
+	return total
+}
+
+func (m *Match) Wins() int {
+	wins := 0
+
+	for _, result := range m.results {
+		if result.Win {
+			wins++
+		}
+	}
+
+	return wins
+}
+
+func (m *Match) IsFinished() bool {
+	return len(m.results) >= m.rounds
+}
+
+func (m *Match) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("rounds", m.rounds),
+		slog.Int("played", len(m.results)),
+		slog.Int("wins", m.Wins()),
+		slog.Int("total score", m.TotalScore()),
+	)
+}
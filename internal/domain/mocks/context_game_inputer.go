@@ -0,0 +1,252 @@
+// Code generated by mockery v2.46.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "makly/hangman/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ContextGameInputer is an autogenerated mock type for the ContextGameInputer type
+type ContextGameInputer struct {
+	mock.Mock
+}
+
+type ContextGameInputer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ContextGameInputer) EXPECT() *ContextGameInputer_Expecter {
+	return &ContextGameInputer_Expecter{mock: &_m.Mock}
+}
+
+// GetGuess provides a mock function with given fields:
+func (_m *ContextGameInputer) GetGuess() (*domain.Guess, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGuess")
+	}
+
+	var r0 *domain.Guess
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*domain.Guess, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *domain.Guess); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Guess)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContextGameInputer_GetGuess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGuess'
+type ContextGameInputer_GetGuess_Call struct {
+	*mock.Call
+}
+
+// GetGuess is a helper method to define mock.On call
+func (_e *ContextGameInputer_Expecter) GetGuess() *ContextGameInputer_GetGuess_Call {
+	return &ContextGameInputer_GetGuess_Call{Call: _e.mock.On("GetGuess")}
+}
+
+func (_c *ContextGameInputer_GetGuess_Call) Run(run func()) *ContextGameInputer_GetGuess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ContextGameInputer_GetGuess_Call) Return(guess *domain.Guess, err error) *ContextGameInputer_GetGuess_Call {
+	_c.Call.Return(guess, err)
+	return _c
+}
+
+func (_c *ContextGameInputer_GetGuess_Call) RunAndReturn(run func() (*domain.Guess, error)) *ContextGameInputer_GetGuess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGuessContext provides a mock function with given fields: ctx
+func (_m *ContextGameInputer) GetGuessContext(ctx context.Context) (*domain.Guess, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGuessContext")
+	}
+
+	var r0 *domain.Guess
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*domain.Guess, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *domain.Guess); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Guess)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContextGameInputer_GetGuessContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGuessContext'
+type ContextGameInputer_GetGuessContext_Call struct {
+	*mock.Call
+}
+
+// GetGuessContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ContextGameInputer_Expecter) GetGuessContext(ctx interface{}) *ContextGameInputer_GetGuessContext_Call {
+	return &ContextGameInputer_GetGuessContext_Call{Call: _e.mock.On("GetGuessContext", ctx)}
+}
+
+func (_c *ContextGameInputer_GetGuessContext_Call) Run(run func(ctx context.Context)) *ContextGameInputer_GetGuessContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ContextGameInputer_GetGuessContext_Call) Return(guess *domain.Guess, err error) *ContextGameInputer_GetGuessContext_Call {
+	_c.Call.Return(guess, err)
+	return _c
+}
+
+func (_c *ContextGameInputer_GetGuessContext_Call) RunAndReturn(run func(context.Context) (*domain.Guess, error)) *ContextGameInputer_GetGuessContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLetter provides a mock function with given fields:
+func (_m *ContextGameInputer) GetLetter() (rune, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLetter")
+	}
+
+	var r0 rune
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (rune, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() rune); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(rune)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContextGameInputer_GetLetter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLetter'
+type ContextGameInputer_GetLetter_Call struct {
+	*mock.Call
+}
+
+// GetLetter is a helper method to define mock.On call
+func (_e *ContextGameInputer_Expecter) GetLetter() *ContextGameInputer_GetLetter_Call {
+	return &ContextGameInputer_GetLetter_Call{Call: _e.mock.On("GetLetter")}
+}
+
+func (_c *ContextGameInputer_GetLetter_Call) Run(run func()) *ContextGameInputer_GetLetter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ContextGameInputer_GetLetter_Call) Return(letter rune, err error) *ContextGameInputer_GetLetter_Call {
+	_c.Call.Return(letter, err)
+	return _c
+}
+
+func (_c *ContextGameInputer_GetLetter_Call) RunAndReturn(run func() (rune, error)) *ContextGameInputer_GetLetter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitContinue provides a mock function with given fields:
+func (_m *ContextGameInputer) WaitContinue() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitContinue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContextGameInputer_WaitContinue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitContinue'
+type ContextGameInputer_WaitContinue_Call struct {
+	*mock.Call
+}
+
+// WaitContinue is a helper method to define mock.On call
+func (_e *ContextGameInputer_Expecter) WaitContinue() *ContextGameInputer_WaitContinue_Call {
+	return &ContextGameInputer_WaitContinue_Call{Call: _e.mock.On("WaitContinue")}
+}
+
+func (_c *ContextGameInputer_WaitContinue_Call) Run(run func()) *ContextGameInputer_WaitContinue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ContextGameInputer_WaitContinue_Call) Return(err error) *ContextGameInputer_WaitContinue_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ContextGameInputer_WaitContinue_Call) RunAndReturn(run func() error) *ContextGameInputer_WaitContinue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewContextGameInputer creates a new instance of ContextGameInputer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewContextGameInputer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ContextGameInputer {
+	mock := &ContextGameInputer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
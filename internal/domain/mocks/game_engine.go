@@ -0,0 +1,760 @@
+// Code generated by mockery v2.46.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "makly/hangman/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GameEngine is an autogenerated mock type for the GameEngine type
+type GameEngine struct {
+	mock.Mock
+}
+
+type GameEngine_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GameEngine) EXPECT() *GameEngine_Expecter {
+	return &GameEngine_Expecter{mock: &_m.Mock}
+}
+
+// Alphabet provides a mock function with given fields:
+func (_m *GameEngine) Alphabet() *domain.Alphabet {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Alphabet")
+	}
+
+	var r0 *domain.Alphabet
+	if rf, ok := ret.Get(0).(func() *domain.Alphabet); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Alphabet)
+		}
+	}
+
+	return r0
+}
+
+// GameEngine_Alphabet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Alphabet'
+type GameEngine_Alphabet_Call struct {
+	*mock.Call
+}
+
+// Alphabet is a helper method to define mock.On call
+func (_e *GameEngine_Expecter) Alphabet() *GameEngine_Alphabet_Call {
+	return &GameEngine_Alphabet_Call{Call: _e.mock.On("Alphabet")}
+}
+
+func (_c *GameEngine_Alphabet_Call) Run(run func()) *GameEngine_Alphabet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameEngine_Alphabet_Call) Return(_a0 *domain.Alphabet) *GameEngine_Alphabet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameEngine_Alphabet_Call) RunAndReturn(run func() *domain.Alphabet) *GameEngine_Alphabet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Attempts provides a mock function with given fields:
+func (_m *GameEngine) Attempts() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attempts")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GameEngine_Attempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attempts'
+type GameEngine_Attempts_Call struct {
+	*mock.Call
+}
+
+// Attempts is a helper method to define mock.On call
+func (_e *GameEngine_Expecter) Attempts() *GameEngine_Attempts_Call {
+	return &GameEngine_Attempts_Call{Call: _e.mock.On("Attempts")}
+}
+
+func (_c *GameEngine_Attempts_Call) Run(run func()) *GameEngine_Attempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameEngine_Attempts_Call) Return(_a0 int) *GameEngine_Attempts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameEngine_Attempts_Call) RunAndReturn(run func() int) *GameEngine_Attempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CanBuyLetter provides a mock function with given fields:
+func (_m *GameEngine) CanBuyLetter() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CanBuyLetter")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// GameEngine_CanBuyLetter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CanBuyLetter'
+type GameEngine_CanBuyLetter_Call struct {
+	*mock.Call
+}
+
+// CanBuyLetter is a helper method to define mock.On call
+func (_e *GameEngine_Expecter) CanBuyLetter() *GameEngine_CanBuyLetter_Call {
+	return &GameEngine_CanBuyLetter_Call{Call: _e.mock.On("CanBuyLetter")}
+}
+
+func (_c *GameEngine_CanBuyLetter_Call) Run(run func()) *GameEngine_CanBuyLetter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameEngine_CanBuyLetter_Call) Return(_a0 bool) *GameEngine_CanBuyLetter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameEngine_CanBuyLetter_Call) RunAndReturn(run func() bool) *GameEngine_CanBuyLetter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CanUndo provides a mock function with given fields:
+func (_m *GameEngine) CanUndo() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CanUndo")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// GameEngine_CanUndo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CanUndo'
+type GameEngine_CanUndo_Call struct {
+	*mock.Call
+}
+
+// CanUndo is a helper method to define mock.On call
+func (_e *GameEngine_Expecter) CanUndo() *GameEngine_CanUndo_Call {
+	return &GameEngine_CanUndo_Call{Call: _e.mock.On("CanUndo")}
+}
+
+func (_c *GameEngine_CanUndo_Call) Run(run func()) *GameEngine_CanUndo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameEngine_CanUndo_Call) Return(_a0 bool) *GameEngine_CanUndo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameEngine_CanUndo_Call) RunAndReturn(run func() bool) *GameEngine_CanUndo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HintRequestCost provides a mock function with given fields:
+func (_m *GameEngine) HintRequestCost() (int, bool) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HintRequestCost")
+	}
+
+	var r0 int
+	var r1 bool
+	if rf, ok := ret.Get(0).(func() (int, bool)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GameEngine_HintRequestCost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HintRequestCost'
+type GameEngine_HintRequestCost_Call struct {
+	*mock.Call
+}
+
+// HintRequestCost is a helper method to define mock.On call
+func (_e *GameEngine_Expecter) HintRequestCost() *GameEngine_HintRequestCost_Call {
+	return &GameEngine_HintRequestCost_Call{Call: _e.mock.On("HintRequestCost")}
+}
+
+func (_c *GameEngine_HintRequestCost_Call) Run(run func()) *GameEngine_HintRequestCost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameEngine_HintRequestCost_Call) Return(cost int, ok bool) *GameEngine_HintRequestCost_Call {
+	_c.Call.Return(cost, ok)
+	return _c
+}
+
+func (_c *GameEngine_HintRequestCost_Call) RunAndReturn(run func() (int, bool)) *GameEngine_HintRequestCost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Hints provides a mock function with given fields:
+func (_m *GameEngine) Hints() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hints")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// GameEngine_Hints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hints'
+type GameEngine_Hints_Call struct {
+	*mock.Call
+}
+
+// Hints is a helper method to define mock.On call
+func (_e *GameEngine_Expecter) Hints() *GameEngine_Hints_Call {
+	return &GameEngine_Hints_Call{Call: _e.mock.On("Hints")}
+}
+
+func (_c *GameEngine_Hints_Call) Run(run func()) *GameEngine_Hints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameEngine_Hints_Call) Return(_a0 []string) *GameEngine_Hints_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameEngine_Hints_Call) RunAndReturn(run func() []string) *GameEngine_Hints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsFinished provides a mock function with given fields:
+func (_m *GameEngine) IsFinished() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFinished")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// GameEngine_IsFinished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsFinished'
+type GameEngine_IsFinished_Call struct {
+	*mock.Call
+}
+
+// IsFinished is a helper method to define mock.On call
+func (_e *GameEngine_Expecter) IsFinished() *GameEngine_IsFinished_Call {
+	return &GameEngine_IsFinished_Call{Call: _e.mock.On("IsFinished")}
+}
+
+func (_c *GameEngine_IsFinished_Call) Run(run func()) *GameEngine_IsFinished_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameEngine_IsFinished_Call) Return(_a0 bool) *GameEngine_IsFinished_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameEngine_IsFinished_Call) RunAndReturn(run func() bool) *GameEngine_IsFinished_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsLose provides a mock function with given fields:
+func (_m *GameEngine) IsLose() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsLose")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// GameEngine_IsLose_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsLose'
+type GameEngine_IsLose_Call struct {
+	*mock.Call
+}
+
+// IsLose is a helper method to define mock.On call
+func (_e *GameEngine_Expecter) IsLose() *GameEngine_IsLose_Call {
+	return &GameEngine_IsLose_Call{Call: _e.mock.On("IsLose")}
+}
+
+func (_c *GameEngine_IsLose_Call) Run(run func()) *GameEngine_IsLose_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameEngine_IsLose_Call) Return(_a0 bool) *GameEngine_IsLose_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameEngine_IsLose_Call) RunAndReturn(run func() bool) *GameEngine_IsLose_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsWin provides a mock function with given fields:
+func (_m *GameEngine) IsWin() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsWin")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// GameEngine_IsWin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsWin'
+type GameEngine_IsWin_Call struct {
+	*mock.Call
+}
+
+// IsWin is a helper method to define mock.On call
+func (_e *GameEngine_Expecter) IsWin() *GameEngine_IsWin_Call {
+	return &GameEngine_IsWin_Call{Call: _e.mock.On("IsWin")}
+}
+
+func (_c *GameEngine_IsWin_Call) Run(run func()) *GameEngine_IsWin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameEngine_IsWin_Call) Return(_a0 bool) *GameEngine_IsWin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameEngine_IsWin_Call) RunAndReturn(run func() bool) *GameEngine_IsWin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MakeGuess provides a mock function with given fields: guess
+func (_m *GameEngine) MakeGuess(guess *domain.Guess) {
+	_m.Called(guess)
+}
+
+// GameEngine_MakeGuess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeGuess'
+type GameEngine_MakeGuess_Call struct {
+	*mock.Call
+}
+
+// MakeGuess is a helper method to define mock.On call
+//   - guess *domain.Guess
+func (_e *GameEngine_Expecter) MakeGuess(guess interface{}) *GameEngine_MakeGuess_Call {
+	return &GameEngine_MakeGuess_Call{Call: _e.mock.On("MakeGuess", guess)}
+}
+
+func (_c *GameEngine_MakeGuess_Call) Run(run func(guess *domain.Guess)) *GameEngine_MakeGuess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*domain.Guess))
+	})
+	return _c
+}
+
+func (_c *GameEngine_MakeGuess_Call) Return() *GameEngine_MakeGuess_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *GameEngine_MakeGuess_Call) RunAndReturn(run func(*domain.Guess)) *GameEngine_MakeGuess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxMistakes provides a mock function with given fields:
+func (_m *GameEngine) MaxMistakes() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxMistakes")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GameEngine_MaxMistakes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxMistakes'
+type GameEngine_MaxMistakes_Call struct {
+	*mock.Call
+}
+
+// MaxMistakes is a helper method to define mock.On call
+func (_e *GameEngine_Expecter) MaxMistakes() *GameEngine_MaxMistakes_Call {
+	return &GameEngine_MaxMistakes_Call{Call: _e.mock.On("MaxMistakes")}
+}
+
+func (_c *GameEngine_MaxMistakes_Call) Run(run func()) *GameEngine_MaxMistakes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameEngine_MaxMistakes_Call) Return(_a0 int) *GameEngine_MaxMistakes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameEngine_MaxMistakes_Call) RunAndReturn(run func() int) *GameEngine_MaxMistakes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mistakes provides a mock function with given fields:
+func (_m *GameEngine) Mistakes() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mistakes")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GameEngine_Mistakes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mistakes'
+type GameEngine_Mistakes_Call struct {
+	*mock.Call
+}
+
+// Mistakes is a helper method to define mock.On call
+func (_e *GameEngine_Expecter) Mistakes() *GameEngine_Mistakes_Call {
+	return &GameEngine_Mistakes_Call{Call: _e.mock.On("Mistakes")}
+}
+
+func (_c *GameEngine_Mistakes_Call) Run(run func()) *GameEngine_Mistakes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameEngine_Mistakes_Call) Return(_a0 int) *GameEngine_Mistakes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameEngine_Mistakes_Call) RunAndReturn(run func() int) *GameEngine_Mistakes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pattern provides a mock function with given fields:
+func (_m *GameEngine) Pattern() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pattern")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GameEngine_Pattern_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pattern'
+type GameEngine_Pattern_Call struct {
+	*mock.Call
+}
+
+// Pattern is a helper method to define mock.On call
+func (_e *GameEngine_Expecter) Pattern() *GameEngine_Pattern_Call {
+	return &GameEngine_Pattern_Call{Call: _e.mock.On("Pattern")}
+}
+
+func (_c *GameEngine_Pattern_Call) Run(run func()) *GameEngine_Pattern_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameEngine_Pattern_Call) Return(_a0 string) *GameEngine_Pattern_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameEngine_Pattern_Call) RunAndReturn(run func() string) *GameEngine_Pattern_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PowerUps provides a mock function with given fields:
+func (_m *GameEngine) PowerUps() domain.PowerUps {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PowerUps")
+	}
+
+	var r0 domain.PowerUps
+	if rf, ok := ret.Get(0).(func() domain.PowerUps); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(domain.PowerUps)
+	}
+
+	return r0
+}
+
+// GameEngine_PowerUps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PowerUps'
+type GameEngine_PowerUps_Call struct {
+	*mock.Call
+}
+
+// PowerUps is a helper method to define mock.On call
+func (_e *GameEngine_Expecter) PowerUps() *GameEngine_PowerUps_Call {
+	return &GameEngine_PowerUps_Call{Call: _e.mock.On("PowerUps")}
+}
+
+func (_c *GameEngine_PowerUps_Call) Run(run func()) *GameEngine_PowerUps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameEngine_PowerUps_Call) Return(_a0 domain.PowerUps) *GameEngine_PowerUps_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameEngine_PowerUps_Call) RunAndReturn(run func() domain.PowerUps) *GameEngine_PowerUps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// State provides a mock function with given fields:
+func (_m *GameEngine) State() domain.State {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for State")
+	}
+
+	var r0 domain.State
+	if rf, ok := ret.Get(0).(func() domain.State); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(domain.State)
+	}
+
+	return r0
+}
+
+// GameEngine_State_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'State'
+type GameEngine_State_Call struct {
+	*mock.Call
+}
+
+// State is a helper method to define mock.On call
+func (_e *GameEngine_Expecter) State() *GameEngine_State_Call {
+	return &GameEngine_State_Call{Call: _e.mock.On("State")}
+}
+
+func (_c *GameEngine_State_Call) Run(run func()) *GameEngine_State_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameEngine_State_Call) Return(_a0 domain.State) *GameEngine_State_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameEngine_State_Call) RunAndReturn(run func() domain.State) *GameEngine_State_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Used provides a mock function with given fields:
+func (_m *GameEngine) Used() map[rune]bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Used")
+	}
+
+	var r0 map[rune]bool
+	if rf, ok := ret.Get(0).(func() map[rune]bool); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[rune]bool)
+		}
+	}
+
+	return r0
+}
+
+// GameEngine_Used_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Used'
+type GameEngine_Used_Call struct {
+	*mock.Call
+}
+
+// Used is a helper method to define mock.On call
+func (_e *GameEngine_Expecter) Used() *GameEngine_Used_Call {
+	return &GameEngine_Used_Call{Call: _e.mock.On("Used")}
+}
+
+func (_c *GameEngine_Used_Call) Run(run func()) *GameEngine_Used_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameEngine_Used_Call) Return(_a0 map[rune]bool) *GameEngine_Used_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameEngine_Used_Call) RunAndReturn(run func() map[rune]bool) *GameEngine_Used_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewGameEngine creates a new instance of GameEngine. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGameEngine(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GameEngine {
+	mock := &GameEngine{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -2,7 +2,11 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	domain "makly/hangman/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // GameInputer is an autogenerated mock type for the GameInputer type
 type GameInputer struct {
@@ -17,6 +21,63 @@ func (_m *GameInputer) EXPECT() *GameInputer_Expecter {
 	return &GameInputer_Expecter{mock: &_m.Mock}
 }
 
+// GetGuess provides a mock function with given fields:
+func (_m *GameInputer) GetGuess() (*domain.Guess, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGuess")
+	}
+
+	var r0 *domain.Guess
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*domain.Guess, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *domain.Guess); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Guess)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GameInputer_GetGuess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGuess'
+type GameInputer_GetGuess_Call struct {
+	*mock.Call
+}
+
+// GetGuess is a helper method to define mock.On call
+func (_e *GameInputer_Expecter) GetGuess() *GameInputer_GetGuess_Call {
+	return &GameInputer_GetGuess_Call{Call: _e.mock.On("GetGuess")}
+}
+
+func (_c *GameInputer_GetGuess_Call) Run(run func()) *GameInputer_GetGuess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameInputer_GetGuess_Call) Return(guess *domain.Guess, err error) *GameInputer_GetGuess_Call {
+	_c.Call.Return(guess, err)
+	return _c
+}
+
+func (_c *GameInputer_GetGuess_Call) RunAndReturn(run func() (*domain.Guess, error)) *GameInputer_GetGuess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLetter provides a mock function with given fields:
 func (_m *GameInputer) GetLetter() (rune, error) {
 	ret := _m.Called()
@@ -72,6 +133,51 @@ func (_c *GameInputer_GetLetter_Call) RunAndReturn(run func() (rune, error)) *Ga
 	return _c
 }
 
+// WaitContinue provides a mock function with given fields:
+func (_m *GameInputer) WaitContinue() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitContinue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GameInputer_WaitContinue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitContinue'
+type GameInputer_WaitContinue_Call struct {
+	*mock.Call
+}
+
+// WaitContinue is a helper method to define mock.On call
+func (_e *GameInputer_Expecter) WaitContinue() *GameInputer_WaitContinue_Call {
+	return &GameInputer_WaitContinue_Call{Call: _e.mock.On("WaitContinue")}
+}
+
+func (_c *GameInputer_WaitContinue_Call) Run(run func()) *GameInputer_WaitContinue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameInputer_WaitContinue_Call) Return(err error) *GameInputer_WaitContinue_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *GameInputer_WaitContinue_Call) RunAndReturn(run func() error) *GameInputer_WaitContinue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewGameInputer creates a new instance of GameInputer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewGameInputer(t interface {
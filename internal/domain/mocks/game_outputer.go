@@ -4,6 +4,7 @@ package mocks
 
 import (
 	domain "makly/hangman/internal/domain"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -21,8 +22,42 @@ func (_m *GameOutputer) EXPECT() *GameOutputer_Expecter {
 	return &GameOutputer_Expecter{mock: &_m.Mock}
 }
 
+// ShowDailyResult provides a mock function with given fields: result, playedBefore
+func (_m *GameOutputer) ShowDailyResult(result *domain.DailyResult, playedBefore bool) {
+	_m.Called(result, playedBefore)
+}
+
+// GameOutputer_ShowDailyResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowDailyResult'
+type GameOutputer_ShowDailyResult_Call struct {
+	*mock.Call
+}
+
+// ShowDailyResult is a helper method to define mock.On call
+//   - result *domain.DailyResult
+//   - playedBefore bool
+func (_e *GameOutputer_Expecter) ShowDailyResult(result interface{}, playedBefore interface{}) *GameOutputer_ShowDailyResult_Call {
+	return &GameOutputer_ShowDailyResult_Call{Call: _e.mock.On("ShowDailyResult", result, playedBefore)}
+}
+
+func (_c *GameOutputer_ShowDailyResult_Call) Run(run func(result *domain.DailyResult, playedBefore bool)) *GameOutputer_ShowDailyResult_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*domain.DailyResult), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *GameOutputer_ShowDailyResult_Call) Return() *GameOutputer_ShowDailyResult_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *GameOutputer_ShowDailyResult_Call) RunAndReturn(run func(*domain.DailyResult, bool)) *GameOutputer_ShowDailyResult_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ShowGame provides a mock function with given fields: game
-func (_m *GameOutputer) ShowGame(game *domain.Game) {
+func (_m *GameOutputer) ShowGame(game domain.GameView) {
 	_m.Called(game)
 }
 
@@ -32,14 +67,14 @@ type GameOutputer_ShowGame_Call struct {
 }
 
 // ShowGame is a helper method to define mock.On call
-//   - game *domain.Game
+//   - game domain.GameView
 func (_e *GameOutputer_Expecter) ShowGame(game interface{}) *GameOutputer_ShowGame_Call {
 	return &GameOutputer_ShowGame_Call{Call: _e.mock.On("ShowGame", game)}
 }
 
-func (_c *GameOutputer_ShowGame_Call) Run(run func(game *domain.Game)) *GameOutputer_ShowGame_Call {
+func (_c *GameOutputer_ShowGame_Call) Run(run func(game domain.GameView)) *GameOutputer_ShowGame_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*domain.Game))
+		run(args[0].(domain.GameView))
 	})
 	return _c
 }
@@ -49,13 +84,13 @@ func (_c *GameOutputer_ShowGame_Call) Return() *GameOutputer_ShowGame_Call {
 	return _c
 }
 
-func (_c *GameOutputer_ShowGame_Call) RunAndReturn(run func(*domain.Game)) *GameOutputer_ShowGame_Call {
+func (_c *GameOutputer_ShowGame_Call) RunAndReturn(run func(domain.GameView)) *GameOutputer_ShowGame_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ShowGameResult provides a mock function with given fields: game
-func (_m *GameOutputer) ShowGameResult(game *domain.Game) {
+func (_m *GameOutputer) ShowGameResult(game domain.GameView) {
 	_m.Called(game)
 }
 
@@ -65,14 +100,14 @@ type GameOutputer_ShowGameResult_Call struct {
 }
 
 // ShowGameResult is a helper method to define mock.On call
-//   - game *domain.Game
+//   - game domain.GameView
 func (_e *GameOutputer_Expecter) ShowGameResult(game interface{}) *GameOutputer_ShowGameResult_Call {
 	return &GameOutputer_ShowGameResult_Call{Call: _e.mock.On("ShowGameResult", game)}
 }
 
-func (_c *GameOutputer_ShowGameResult_Call) Run(run func(game *domain.Game)) *GameOutputer_ShowGameResult_Call {
+func (_c *GameOutputer_ShowGameResult_Call) Run(run func(game domain.GameView)) *GameOutputer_ShowGameResult_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*domain.Game))
+		run(args[0].(domain.GameView))
 	})
 	return _c
 }
@@ -82,7 +117,73 @@ func (_c *GameOutputer_ShowGameResult_Call) Return() *GameOutputer_ShowGameResul
 	return _c
 }
 
-func (_c *GameOutputer_ShowGameResult_Call) RunAndReturn(run func(*domain.Game)) *GameOutputer_ShowGameResult_Call {
+func (_c *GameOutputer_ShowGameResult_Call) RunAndReturn(run func(domain.GameView)) *GameOutputer_ShowGameResult_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ShowHotSeatGame provides a mock function with given fields: hotSeat
+func (_m *GameOutputer) ShowHotSeatGame(hotSeat *domain.HotSeatGame) {
+	_m.Called(hotSeat)
+}
+
+// GameOutputer_ShowHotSeatGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowHotSeatGame'
+type GameOutputer_ShowHotSeatGame_Call struct {
+	*mock.Call
+}
+
+// ShowHotSeatGame is a helper method to define mock.On call
+//   - hotSeat *domain.HotSeatGame
+func (_e *GameOutputer_Expecter) ShowHotSeatGame(hotSeat interface{}) *GameOutputer_ShowHotSeatGame_Call {
+	return &GameOutputer_ShowHotSeatGame_Call{Call: _e.mock.On("ShowHotSeatGame", hotSeat)}
+}
+
+func (_c *GameOutputer_ShowHotSeatGame_Call) Run(run func(hotSeat *domain.HotSeatGame)) *GameOutputer_ShowHotSeatGame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*domain.HotSeatGame))
+	})
+	return _c
+}
+
+func (_c *GameOutputer_ShowHotSeatGame_Call) Return() *GameOutputer_ShowHotSeatGame_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *GameOutputer_ShowHotSeatGame_Call) RunAndReturn(run func(*domain.HotSeatGame)) *GameOutputer_ShowHotSeatGame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ShowHotSeatResult provides a mock function with given fields: hotSeat
+func (_m *GameOutputer) ShowHotSeatResult(hotSeat *domain.HotSeatGame) {
+	_m.Called(hotSeat)
+}
+
+// GameOutputer_ShowHotSeatResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowHotSeatResult'
+type GameOutputer_ShowHotSeatResult_Call struct {
+	*mock.Call
+}
+
+// ShowHotSeatResult is a helper method to define mock.On call
+//   - hotSeat *domain.HotSeatGame
+func (_e *GameOutputer_Expecter) ShowHotSeatResult(hotSeat interface{}) *GameOutputer_ShowHotSeatResult_Call {
+	return &GameOutputer_ShowHotSeatResult_Call{Call: _e.mock.On("ShowHotSeatResult", hotSeat)}
+}
+
+func (_c *GameOutputer_ShowHotSeatResult_Call) Run(run func(hotSeat *domain.HotSeatGame)) *GameOutputer_ShowHotSeatResult_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*domain.HotSeatGame))
+	})
+	return _c
+}
+
+func (_c *GameOutputer_ShowHotSeatResult_Call) Return() *GameOutputer_ShowHotSeatResult_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *GameOutputer_ShowHotSeatResult_Call) RunAndReturn(run func(*domain.HotSeatGame)) *GameOutputer_ShowHotSeatResult_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -120,6 +221,141 @@ func (_c *GameOutputer_ShowInputError_Call) RunAndReturn(run func(error)) *GameO
 	return _c
 }
 
+// ShowOpponentResult provides a mock function with given fields: game, guesses
+func (_m *GameOutputer) ShowOpponentResult(game domain.GameView, guesses []string) {
+	_m.Called(game, guesses)
+}
+
+// GameOutputer_ShowOpponentResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowOpponentResult'
+type GameOutputer_ShowOpponentResult_Call struct {
+	*mock.Call
+}
+
+// ShowOpponentResult is a helper method to define mock.On call
+//   - game domain.GameView
+//   - guesses []string
+func (_e *GameOutputer_Expecter) ShowOpponentResult(game interface{}, guesses interface{}) *GameOutputer_ShowOpponentResult_Call {
+	return &GameOutputer_ShowOpponentResult_Call{Call: _e.mock.On("ShowOpponentResult", game, guesses)}
+}
+
+func (_c *GameOutputer_ShowOpponentResult_Call) Run(run func(game domain.GameView, guesses []string)) *GameOutputer_ShowOpponentResult_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.GameView), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *GameOutputer_ShowOpponentResult_Call) Return() *GameOutputer_ShowOpponentResult_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *GameOutputer_ShowOpponentResult_Call) RunAndReturn(run func(domain.GameView, []string)) *GameOutputer_ShowOpponentResult_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ShowReplayStep provides a mock function with given fields: game, event
+func (_m *GameOutputer) ShowReplayStep(game domain.GameView, event *domain.Event) {
+	_m.Called(game, event)
+}
+
+// GameOutputer_ShowReplayStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowReplayStep'
+type GameOutputer_ShowReplayStep_Call struct {
+	*mock.Call
+}
+
+// ShowReplayStep is a helper method to define mock.On call
+//   - game domain.GameView
+//   - event *domain.Event
+func (_e *GameOutputer_Expecter) ShowReplayStep(game interface{}, event interface{}) *GameOutputer_ShowReplayStep_Call {
+	return &GameOutputer_ShowReplayStep_Call{Call: _e.mock.On("ShowReplayStep", game, event)}
+}
+
+func (_c *GameOutputer_ShowReplayStep_Call) Run(run func(game domain.GameView, event *domain.Event)) *GameOutputer_ShowReplayStep_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.GameView), args[1].(*domain.Event))
+	})
+	return _c
+}
+
+func (_c *GameOutputer_ShowReplayStep_Call) Return() *GameOutputer_ShowReplayStep_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *GameOutputer_ShowReplayStep_Call) RunAndReturn(run func(domain.GameView, *domain.Event)) *GameOutputer_ShowReplayStep_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ShowRoundSummary provides a mock function with given fields: match
+func (_m *GameOutputer) ShowRoundSummary(match *domain.Match) {
+	_m.Called(match)
+}
+
+// GameOutputer_ShowRoundSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowRoundSummary'
+type GameOutputer_ShowRoundSummary_Call struct {
+	*mock.Call
+}
+
+// ShowRoundSummary is a helper method to define mock.On call
+//   - match *domain.Match
+func (_e *GameOutputer_Expecter) ShowRoundSummary(match interface{}) *GameOutputer_ShowRoundSummary_Call {
+	return &GameOutputer_ShowRoundSummary_Call{Call: _e.mock.On("ShowRoundSummary", match)}
+}
+
+func (_c *GameOutputer_ShowRoundSummary_Call) Run(run func(match *domain.Match)) *GameOutputer_ShowRoundSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*domain.Match))
+	})
+	return _c
+}
+
+func (_c *GameOutputer_ShowRoundSummary_Call) Return() *GameOutputer_ShowRoundSummary_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *GameOutputer_ShowRoundSummary_Call) RunAndReturn(run func(*domain.Match)) *GameOutputer_ShowRoundSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ShowTimeLeft provides a mock function with given fields: guessLeft, gameLeft
+func (_m *GameOutputer) ShowTimeLeft(guessLeft time.Duration, gameLeft time.Duration) {
+	_m.Called(guessLeft, gameLeft)
+}
+
+// GameOutputer_ShowTimeLeft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowTimeLeft'
+type GameOutputer_ShowTimeLeft_Call struct {
+	*mock.Call
+}
+
+// ShowTimeLeft is a helper method to define mock.On call
+//   - guessLeft time.Duration
+//   - gameLeft time.Duration
+func (_e *GameOutputer_Expecter) ShowTimeLeft(guessLeft interface{}, gameLeft interface{}) *GameOutputer_ShowTimeLeft_Call {
+	return &GameOutputer_ShowTimeLeft_Call{Call: _e.mock.On("ShowTimeLeft", guessLeft, gameLeft)}
+}
+
+func (_c *GameOutputer_ShowTimeLeft_Call) Run(run func(guessLeft time.Duration, gameLeft time.Duration)) *GameOutputer_ShowTimeLeft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *GameOutputer_ShowTimeLeft_Call) Return() *GameOutputer_ShowTimeLeft_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *GameOutputer_ShowTimeLeft_Call) RunAndReturn(run func(time.Duration, time.Duration)) *GameOutputer_ShowTimeLeft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewGameOutputer creates a new instance of GameOutputer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewGameOutputer(t interface {
@@ -0,0 +1,727 @@
+// Code generated by mockery v2.46.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "makly/hangman/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GameView is an autogenerated mock type for the GameView type
+type GameView struct {
+	mock.Mock
+}
+
+type GameView_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GameView) EXPECT() *GameView_Expecter {
+	return &GameView_Expecter{mock: &_m.Mock}
+}
+
+// Alphabet provides a mock function with given fields:
+func (_m *GameView) Alphabet() *domain.Alphabet {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Alphabet")
+	}
+
+	var r0 *domain.Alphabet
+	if rf, ok := ret.Get(0).(func() *domain.Alphabet); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Alphabet)
+		}
+	}
+
+	return r0
+}
+
+// GameView_Alphabet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Alphabet'
+type GameView_Alphabet_Call struct {
+	*mock.Call
+}
+
+// Alphabet is a helper method to define mock.On call
+func (_e *GameView_Expecter) Alphabet() *GameView_Alphabet_Call {
+	return &GameView_Alphabet_Call{Call: _e.mock.On("Alphabet")}
+}
+
+func (_c *GameView_Alphabet_Call) Run(run func()) *GameView_Alphabet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameView_Alphabet_Call) Return(_a0 *domain.Alphabet) *GameView_Alphabet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameView_Alphabet_Call) RunAndReturn(run func() *domain.Alphabet) *GameView_Alphabet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Attempts provides a mock function with given fields:
+func (_m *GameView) Attempts() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attempts")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GameView_Attempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attempts'
+type GameView_Attempts_Call struct {
+	*mock.Call
+}
+
+// Attempts is a helper method to define mock.On call
+func (_e *GameView_Expecter) Attempts() *GameView_Attempts_Call {
+	return &GameView_Attempts_Call{Call: _e.mock.On("Attempts")}
+}
+
+func (_c *GameView_Attempts_Call) Run(run func()) *GameView_Attempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameView_Attempts_Call) Return(_a0 int) *GameView_Attempts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameView_Attempts_Call) RunAndReturn(run func() int) *GameView_Attempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CanBuyLetter provides a mock function with given fields:
+func (_m *GameView) CanBuyLetter() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CanBuyLetter")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// GameView_CanBuyLetter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CanBuyLetter'
+type GameView_CanBuyLetter_Call struct {
+	*mock.Call
+}
+
+// CanBuyLetter is a helper method to define mock.On call
+func (_e *GameView_Expecter) CanBuyLetter() *GameView_CanBuyLetter_Call {
+	return &GameView_CanBuyLetter_Call{Call: _e.mock.On("CanBuyLetter")}
+}
+
+func (_c *GameView_CanBuyLetter_Call) Run(run func()) *GameView_CanBuyLetter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameView_CanBuyLetter_Call) Return(_a0 bool) *GameView_CanBuyLetter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameView_CanBuyLetter_Call) RunAndReturn(run func() bool) *GameView_CanBuyLetter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CanUndo provides a mock function with given fields:
+func (_m *GameView) CanUndo() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CanUndo")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// GameView_CanUndo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CanUndo'
+type GameView_CanUndo_Call struct {
+	*mock.Call
+}
+
+// CanUndo is a helper method to define mock.On call
+func (_e *GameView_Expecter) CanUndo() *GameView_CanUndo_Call {
+	return &GameView_CanUndo_Call{Call: _e.mock.On("CanUndo")}
+}
+
+func (_c *GameView_CanUndo_Call) Run(run func()) *GameView_CanUndo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameView_CanUndo_Call) Return(_a0 bool) *GameView_CanUndo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameView_CanUndo_Call) RunAndReturn(run func() bool) *GameView_CanUndo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HintRequestCost provides a mock function with given fields:
+func (_m *GameView) HintRequestCost() (int, bool) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HintRequestCost")
+	}
+
+	var r0 int
+	var r1 bool
+	if rf, ok := ret.Get(0).(func() (int, bool)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GameView_HintRequestCost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HintRequestCost'
+type GameView_HintRequestCost_Call struct {
+	*mock.Call
+}
+
+// HintRequestCost is a helper method to define mock.On call
+func (_e *GameView_Expecter) HintRequestCost() *GameView_HintRequestCost_Call {
+	return &GameView_HintRequestCost_Call{Call: _e.mock.On("HintRequestCost")}
+}
+
+func (_c *GameView_HintRequestCost_Call) Run(run func()) *GameView_HintRequestCost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameView_HintRequestCost_Call) Return(cost int, ok bool) *GameView_HintRequestCost_Call {
+	_c.Call.Return(cost, ok)
+	return _c
+}
+
+func (_c *GameView_HintRequestCost_Call) RunAndReturn(run func() (int, bool)) *GameView_HintRequestCost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Hints provides a mock function with given fields:
+func (_m *GameView) Hints() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hints")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// GameView_Hints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hints'
+type GameView_Hints_Call struct {
+	*mock.Call
+}
+
+// Hints is a helper method to define mock.On call
+func (_e *GameView_Expecter) Hints() *GameView_Hints_Call {
+	return &GameView_Hints_Call{Call: _e.mock.On("Hints")}
+}
+
+func (_c *GameView_Hints_Call) Run(run func()) *GameView_Hints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameView_Hints_Call) Return(_a0 []string) *GameView_Hints_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameView_Hints_Call) RunAndReturn(run func() []string) *GameView_Hints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsFinished provides a mock function with given fields:
+func (_m *GameView) IsFinished() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFinished")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// GameView_IsFinished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsFinished'
+type GameView_IsFinished_Call struct {
+	*mock.Call
+}
+
+// IsFinished is a helper method to define mock.On call
+func (_e *GameView_Expecter) IsFinished() *GameView_IsFinished_Call {
+	return &GameView_IsFinished_Call{Call: _e.mock.On("IsFinished")}
+}
+
+func (_c *GameView_IsFinished_Call) Run(run func()) *GameView_IsFinished_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameView_IsFinished_Call) Return(_a0 bool) *GameView_IsFinished_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameView_IsFinished_Call) RunAndReturn(run func() bool) *GameView_IsFinished_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsLose provides a mock function with given fields:
+func (_m *GameView) IsLose() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsLose")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// GameView_IsLose_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsLose'
+type GameView_IsLose_Call struct {
+	*mock.Call
+}
+
+// IsLose is a helper method to define mock.On call
+func (_e *GameView_Expecter) IsLose() *GameView_IsLose_Call {
+	return &GameView_IsLose_Call{Call: _e.mock.On("IsLose")}
+}
+
+func (_c *GameView_IsLose_Call) Run(run func()) *GameView_IsLose_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameView_IsLose_Call) Return(_a0 bool) *GameView_IsLose_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameView_IsLose_Call) RunAndReturn(run func() bool) *GameView_IsLose_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsWin provides a mock function with given fields:
+func (_m *GameView) IsWin() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsWin")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// GameView_IsWin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsWin'
+type GameView_IsWin_Call struct {
+	*mock.Call
+}
+
+// IsWin is a helper method to define mock.On call
+func (_e *GameView_Expecter) IsWin() *GameView_IsWin_Call {
+	return &GameView_IsWin_Call{Call: _e.mock.On("IsWin")}
+}
+
+func (_c *GameView_IsWin_Call) Run(run func()) *GameView_IsWin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameView_IsWin_Call) Return(_a0 bool) *GameView_IsWin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameView_IsWin_Call) RunAndReturn(run func() bool) *GameView_IsWin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxMistakes provides a mock function with given fields:
+func (_m *GameView) MaxMistakes() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxMistakes")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GameView_MaxMistakes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxMistakes'
+type GameView_MaxMistakes_Call struct {
+	*mock.Call
+}
+
+// MaxMistakes is a helper method to define mock.On call
+func (_e *GameView_Expecter) MaxMistakes() *GameView_MaxMistakes_Call {
+	return &GameView_MaxMistakes_Call{Call: _e.mock.On("MaxMistakes")}
+}
+
+func (_c *GameView_MaxMistakes_Call) Run(run func()) *GameView_MaxMistakes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameView_MaxMistakes_Call) Return(_a0 int) *GameView_MaxMistakes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameView_MaxMistakes_Call) RunAndReturn(run func() int) *GameView_MaxMistakes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mistakes provides a mock function with given fields:
+func (_m *GameView) Mistakes() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mistakes")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GameView_Mistakes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mistakes'
+type GameView_Mistakes_Call struct {
+	*mock.Call
+}
+
+// Mistakes is a helper method to define mock.On call
+func (_e *GameView_Expecter) Mistakes() *GameView_Mistakes_Call {
+	return &GameView_Mistakes_Call{Call: _e.mock.On("Mistakes")}
+}
+
+func (_c *GameView_Mistakes_Call) Run(run func()) *GameView_Mistakes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameView_Mistakes_Call) Return(_a0 int) *GameView_Mistakes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameView_Mistakes_Call) RunAndReturn(run func() int) *GameView_Mistakes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pattern provides a mock function with given fields:
+func (_m *GameView) Pattern() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pattern")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GameView_Pattern_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pattern'
+type GameView_Pattern_Call struct {
+	*mock.Call
+}
+
+// Pattern is a helper method to define mock.On call
+func (_e *GameView_Expecter) Pattern() *GameView_Pattern_Call {
+	return &GameView_Pattern_Call{Call: _e.mock.On("Pattern")}
+}
+
+func (_c *GameView_Pattern_Call) Run(run func()) *GameView_Pattern_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameView_Pattern_Call) Return(_a0 string) *GameView_Pattern_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameView_Pattern_Call) RunAndReturn(run func() string) *GameView_Pattern_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PowerUps provides a mock function with given fields:
+func (_m *GameView) PowerUps() domain.PowerUps {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PowerUps")
+	}
+
+	var r0 domain.PowerUps
+	if rf, ok := ret.Get(0).(func() domain.PowerUps); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(domain.PowerUps)
+	}
+
+	return r0
+}
+
+// GameView_PowerUps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PowerUps'
+type GameView_PowerUps_Call struct {
+	*mock.Call
+}
+
+// PowerUps is a helper method to define mock.On call
+func (_e *GameView_Expecter) PowerUps() *GameView_PowerUps_Call {
+	return &GameView_PowerUps_Call{Call: _e.mock.On("PowerUps")}
+}
+
+func (_c *GameView_PowerUps_Call) Run(run func()) *GameView_PowerUps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameView_PowerUps_Call) Return(_a0 domain.PowerUps) *GameView_PowerUps_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameView_PowerUps_Call) RunAndReturn(run func() domain.PowerUps) *GameView_PowerUps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// State provides a mock function with given fields:
+func (_m *GameView) State() domain.State {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for State")
+	}
+
+	var r0 domain.State
+	if rf, ok := ret.Get(0).(func() domain.State); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(domain.State)
+	}
+
+	return r0
+}
+
+// GameView_State_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'State'
+type GameView_State_Call struct {
+	*mock.Call
+}
+
+// State is a helper method to define mock.On call
+func (_e *GameView_Expecter) State() *GameView_State_Call {
+	return &GameView_State_Call{Call: _e.mock.On("State")}
+}
+
+func (_c *GameView_State_Call) Run(run func()) *GameView_State_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameView_State_Call) Return(_a0 domain.State) *GameView_State_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameView_State_Call) RunAndReturn(run func() domain.State) *GameView_State_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Used provides a mock function with given fields:
+func (_m *GameView) Used() map[rune]bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Used")
+	}
+
+	var r0 map[rune]bool
+	if rf, ok := ret.Get(0).(func() map[rune]bool); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[rune]bool)
+		}
+	}
+
+	return r0
+}
+
+// GameView_Used_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Used'
+type GameView_Used_Call struct {
+	*mock.Call
+}
+
+// Used is a helper method to define mock.On call
+func (_e *GameView_Expecter) Used() *GameView_Used_Call {
+	return &GameView_Used_Call{Call: _e.mock.On("Used")}
+}
+
+func (_c *GameView_Used_Call) Run(run func()) *GameView_Used_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GameView_Used_Call) Return(_a0 map[rune]bool) *GameView_Used_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GameView_Used_Call) RunAndReturn(run func() map[rune]bool) *GameView_Used_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewGameView creates a new instance of GameView. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGameView(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GameView {
+	mock := &GameView{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,182 @@
+// Code generated by mockery v2.46.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "makly/hangman/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HintPolicy is an autogenerated mock type for the HintPolicy type
+type HintPolicy struct {
+	mock.Mock
+}
+
+type HintPolicy_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HintPolicy) EXPECT() *HintPolicy_Expecter {
+	return &HintPolicy_Expecter{mock: &_m.Mock}
+}
+
+// RequestCost provides a mock function with given fields:
+func (_m *HintPolicy) RequestCost() (int, bool) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestCost")
+	}
+
+	var r0 int
+	var r1 bool
+	if rf, ok := ret.Get(0).(func() (int, bool)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// HintPolicy_RequestCost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestCost'
+type HintPolicy_RequestCost_Call struct {
+	*mock.Call
+}
+
+// RequestCost is a helper method to define mock.On call
+func (_e *HintPolicy_Expecter) RequestCost() *HintPolicy_RequestCost_Call {
+	return &HintPolicy_RequestCost_Call{Call: _e.mock.On("RequestCost")}
+}
+
+func (_c *HintPolicy_RequestCost_Call) Run(run func()) *HintPolicy_RequestCost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HintPolicy_RequestCost_Call) Return(cost int, ok bool) *HintPolicy_RequestCost_Call {
+	_c.Call.Return(cost, ok)
+	return _c
+}
+
+func (_c *HintPolicy_RequestCost_Call) RunAndReturn(run func() (int, bool)) *HintPolicy_RequestCost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Shown provides a mock function with given fields: progress
+func (_m *HintPolicy) Shown(progress domain.HintProgress) int {
+	ret := _m.Called(progress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Shown")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(domain.HintProgress) int); ok {
+		r0 = rf(progress)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// HintPolicy_Shown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Shown'
+type HintPolicy_Shown_Call struct {
+	*mock.Call
+}
+
+// Shown is a helper method to define mock.On call
+//   - progress domain.HintProgress
+func (_e *HintPolicy_Expecter) Shown(progress interface{}) *HintPolicy_Shown_Call {
+	return &HintPolicy_Shown_Call{Call: _e.mock.On("Shown", progress)}
+}
+
+func (_c *HintPolicy_Shown_Call) Run(run func(progress domain.HintProgress)) *HintPolicy_Shown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.HintProgress))
+	})
+	return _c
+}
+
+func (_c *HintPolicy_Shown_Call) Return(_a0 int) *HintPolicy_Shown_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HintPolicy_Shown_Call) RunAndReturn(run func(domain.HintProgress) int) *HintPolicy_Shown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// String provides a mock function with given fields:
+func (_m *HintPolicy) String() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for String")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// HintPolicy_String_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'String'
+type HintPolicy_String_Call struct {
+	*mock.Call
+}
+
+// String is a helper method to define mock.On call
+func (_e *HintPolicy_Expecter) String() *HintPolicy_String_Call {
+	return &HintPolicy_String_Call{Call: _e.mock.On("String")}
+}
+
+func (_c *HintPolicy_String_Call) Run(run func()) *HintPolicy_String_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HintPolicy_String_Call) Return(_a0 string) *HintPolicy_String_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HintPolicy_String_Call) RunAndReturn(run func() string) *HintPolicy_String_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHintPolicy creates a new instance of HintPolicy. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHintPolicy(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HintPolicy {
+	mock := &HintPolicy{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
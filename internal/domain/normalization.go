@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+)
+
+type NormalizationJSON struct {
+	Policy string `json:"policy"`
+	// Equivalents map variant letters to the base letters they are guessed with, e.g. "ё": "е".
+	Equivalents map[string]string `json:"equivalents"`
+}
+
+func (n *NormalizationJSON) ToDomain() (normalization *Normalization, err error) {
+	if n == nil {
+		return NoNormalization, nil
+	}
+
+	base, ok := NormalizationByPolicy(n.Policy)
+	if !ok {
+		return nil, &BadNormalizationError{Message: fmt.Sprintf("unknown policy %q", n.Policy)}
+	}
+
+	if len(n.Equivalents) == 0 {
+		return base, nil
+	}
+
+	equivalents := make(map[rune]rune, len(base.equivalents)+len(n.Equivalents))
+	for variant, letter := range base.equivalents {
+		equivalents[variant] = letter
+	}
+
+	for variant, letter := range n.Equivalents {
+		variantRunes, letterRunes := []rune(variant), []rune(letter)
+		if len(variantRunes) != 1 || len(letterRunes) != 1 {
+			return nil, &BadNormalizationError{Message: fmt.Sprintf("equivalent %q -> %q must map a single letter", variant, letter)}
+		}
+
+		equivalents[variantRunes[0]] = letterRunes[0]
+	}
+
+	return &Normalization{policy: base.policy, equivalents: equivalents}, nil
+}
+
+// Normalization decides which letters are guessed together, so that guessing "e" reveals "é", "è" and "ê".
+type Normalization struct {
+	policy      string
+	equivalents map[rune]rune
+}
+
+var (
+	NoNormalization         = &Normalization{policy: "none", equivalents: map[rune]rune{}}
+	DiacriticsNormalization = &Normalization{policy: "diacritics", equivalents: diacriticEquivalents()}
+)
+
+func diacriticEquivalents() map[rune]rune {
+	variants := map[rune]string{
+		'a': "àáâãäåāăą",
+		'c': "çćĉċč",
+		'd': "ď",
+		'e': "èéêëēĕėęě",
+		'g': "ĝğġģ",
+		'i': "ìíîïĩīĭį",
+		'l': "ĺļľł",
+		'n': "ñńņň",
+		'o': "òóôõöøōŏő",
+		'r': "ŕŗř",
+		's': "śŝşš",
+		't': "ţť",
+		'u': "ùúûüũūŭůűų",
+		'y': "ýÿ",
+		'z': "źżž",
+		'е': "ё",
+	}
+
+	equivalents := make(map[rune]rune)
+
+	for letter, letterVariants := range variants {
+		for _, variant := range letterVariants {
+			equivalents[variant] = letter
+		}
+	}
+
+	return equivalents
+}
+
+func NormalizationByPolicy(policy string) (normalization *Normalization, ok bool) {
+	switch policy {
+	case "", NoNormalization.policy:
+		return NoNormalization, true
+	case DiacriticsNormalization.policy:
+		return DiacriticsNormalization, true
+	default:
+		return nil, false
+	}
+}
+
+func (n *Normalization) Policy() string {
+	return n.policy
+}
+
+func (n *Normalization) ToJSON() *NormalizationJSON {
+	base, _ := NormalizationByPolicy(n.policy)
+	equivalents := make(map[string]string)
+
+	// Only the equivalents added on top of the policy are stored
+	for variant, letter := range n.equivalents {
+		if base.equivalents[variant] != letter {
+			equivalents[string(variant)] = string(letter)
+		}
+	}
+
+	return &NormalizationJSON{Policy: n.policy, Equivalents: equivalents}
+}
+
+func (n *Normalization) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("policy", n.policy),
+		slog.Int("equivalents", len(n.equivalents)),
+	)
+}
+
+// WithNormalization returns a copy of the alphabet where variant letters are guessed with their base letters.
+// Equivalents whose base letter is not in the alphabet do not apply, and variants are no longer separate letters.
+func (a *Alphabet) WithNormalization(normalization *Normalization) *Alphabet {
+	if len(normalization.equivalents) == 0 && len(a.equivalents) == 0 {
+		return a
+	}
+
+	normalized := *a
+	normalized.normalization = normalization
+	normalized.equivalents = make(map[rune]rune)
+
+	for variant, letter := range normalization.equivalents {
+		if variant != letter && a.isLetter[letter] {
+			normalized.equivalents[variant] = letter
+		}
+	}
+
+	normalized.letters = slices.DeleteFunc(slices.Clone(a.letters), func(letter rune) bool {
+		_, isVariant := normalized.equivalents[letter]
+		return isVariant
+	})
+
+	normalized.isLetter = make(map[rune]bool, len(normalized.letters))
+	for _, letter := range normalized.letters {
+		normalized.isLetter[letter] = true
+	}
+
+	return &normalized
+}
+
+func (a *Alphabet) Normalization() *Normalization {
+	if a.normalization == nil {
+		return NoNormalization
+	}
+
+	return a.normalization
+}
+
+// Normalize folds the character and replaces a variant letter with its base letter.
+func (a *Alphabet) Normalize(char rune) rune {
+	char = a.Fold(char)
+
+	if letter, ok := a.equivalents[char]; ok {
+		return letter
+	}
+
+	return char
+}
+
+func (a *Alphabet) NormalizeWord(word string) string {
+	runes := []rune(word)
+	for i, char := range runes {
+		runes[i] = a.Normalize(char)
+	}
+
+	return string(runes)
+}
+
+// NormalizeGuessWord also trims the spaces around the word and collapses the spaces inside it,
+// so a typed word is compared with the secret word by its letters and the words of the phrase only.
+func (a *Alphabet) NormalizeGuessWord(word string) string {
+	return a.NormalizeWord(strings.Join(strings.Fields(word), " "))
+}
+
+type BadNormalizationError struct {
+	Message string
+}
+
+func (e *BadNormalizationError) Error() string {
+	return fmt.Sprintf("bad normalization: %s", e.Message)
+}
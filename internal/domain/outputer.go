@@ -1,7 +1,20 @@
 package domain
 
+import "time"
+
 type GameOutputer interface {
-	ShowGame(game *Game)
-	ShowGameResult(game *Game)
+	ShowGame(game GameView)
+	ShowGameResult(game GameView)
+	// ShowOpponentResult shows how the solver played the word of a practice game with the guesses it made.
+	ShowOpponentResult(game GameView, guesses []string)
 	ShowInputError(err error)
+	// ShowTimeLeft is called while a timed game waits for a guess, a zero duration is not limited.
+	ShowTimeLeft(guessLeft, gameLeft time.Duration)
+	// ShowReplayStep shows a recorded game right after the event.
+	ShowReplayStep(game GameView, event *Event)
+	// ShowDailyResult is called after the daily challenge and when it is started again on the same day.
+	ShowDailyResult(result *DailyResult, playedBefore bool)
+	ShowRoundSummary(match *Match)
+	ShowHotSeatGame(hotSeat *HotSeatGame)
+	ShowHotSeatResult(hotSeat *HotSeatGame)
 }
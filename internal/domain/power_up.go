@@ -0,0 +1,19 @@
+package domain
+
+import "log/slog"
+
+const DefaultLetterCost = 1
+
+// PowerUps are the letters a player can buy during a game, every bought letter costs LetterCost mistakes
+// and does not score in a match.
+type PowerUps struct {
+	Letters    int
+	LetterCost int
+}
+
+func (p PowerUps) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("letters", p.Letters),
+		slog.Int("letterCost", p.LetterCost),
+	)
+}
@@ -0,0 +1,372 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"time"
+)
+
+const (
+	ProfileVersion     = 1
+	DefaultProfileName = "default"
+	// MostMissedLetters is how many letters the stats show.
+	MostMissedLetters = 5
+)
+
+// profileNamePattern keeps profile names usable as file names.
+var profileNamePattern = regexp.MustCompile(`^[\p{L}\p{N}_-]{1,32}$`)
+
+func ValidateProfileName(name string) error {
+	if !profileNamePattern.MatchString(name) {
+		return &BadProfileError{Message: fmt.Sprintf("name %q must be 1-32 letters, digits, '-' or '_'", name)}
+	}
+
+	return nil
+}
+
+type GameResultJSON struct {
+	Word       string    `json:"word"`
+	Category   string    `json:"category"`
+	Difficulty string    `json:"difficulty"`
+	Mistakes   int       `json:"mistakes"`
+	Attempts   int       `json:"attempts"`
+	Duration   string    `json:"duration"`
+	Won        bool      `json:"won"`
+	Missed     []string  `json:"missed"`
+	FinishedAt time.Time `json:"finishedAt"`
+}
+
+func (g *GameResultJSON) ToDomain() (result *GameResult, err error) {
+	result = &GameResult{
+		Word:       g.Word,
+		Category:   g.Category,
+		Difficulty: g.Difficulty,
+		Mistakes:   g.Mistakes,
+		Attempts:   g.Attempts,
+		Won:        g.Won,
+		FinishedAt: g.FinishedAt,
+	}
+
+	result.Duration, err = time.ParseDuration(g.Duration)
+	if err != nil {
+		return nil, &BadProfileError{Message: fmt.Sprintf("bad duration %q", g.Duration)}
+	}
+
+	for _, letter := range g.Missed {
+		runes := []rune(letter)
+		if len(runes) != 1 {
+			return nil, &BadProfileError{Message: fmt.Sprintf("missed letter %q is not a single letter", letter)}
+		}
+
+		result.Missed = append(result.Missed, runes[0])
+	}
+
+	return result, nil
+}
+
+// GameResult is a finished game kept in a profile.
+type GameResult struct {
+	Word     string
+	Category string
+	// Difficulty is the name of the level, because the games of a profile are played from collections with different levels.
+	Difficulty string
+	Mistakes   int
+	Attempts   int
+	Duration   time.Duration
+	Won        bool
+	Missed     []rune
+	FinishedAt time.Time
+}
+
+func NewGameResult(savedGame *SavedGame) *GameResult {
+	game := savedGame.Game
+
+	return &GameResult{
+		Word:       game.word.Word,
+		Category:   savedGame.Category,
+		Difficulty: savedGame.Levels.Name(savedGame.Difficulty),
+		Mistakes:   game.mistakes,
+		Attempts:   game.attempts,
+		Duration:   game.Duration(),
+		Won:        game.IsWin(),
+		Missed:     game.MissedLetters(),
+		FinishedAt: game.FinishedAt(),
+	}
+}
+
+// NewEvilGameResult keeps the word the evil game ends on.
+func NewEvilGameResult(category, difficulty string, game *EvilGame) *GameResult {
+	return &GameResult{
+		Word:       game.Word().Word,
+		Category:   category,
+		Difficulty: difficulty,
+		Mistakes:   game.mistakes,
+		Attempts:   game.attempts,
+		Duration:   game.Duration(),
+		Won:        game.IsWin(),
+		Missed:     game.MissedLetters(),
+		FinishedAt: game.FinishedAt(),
+	}
+}
+
+// NewPlayerResult is the result of a hot seat player, only the player who reveals the last letter wins.
+func NewPlayerResult(category, difficulty string, hotSeat *HotSeatGame, player *Player) *GameResult {
+	game := hotSeat.game
+	missed := make([]rune, 0, len(player.missed))
+
+	for _, letter := range game.alphabet.Letters() {
+		if slices.Contains(player.missed, letter) {
+			missed = append(missed, letter)
+		}
+	}
+
+	return &GameResult{
+		Word:       game.word.Word,
+		Category:   category,
+		Difficulty: difficulty,
+		Mistakes:   player.mistakes,
+		Attempts:   player.attempts,
+		Duration:   game.Duration(),
+		Won:        hotSeat.Winner() == player,
+		Missed:     missed,
+		FinishedAt: game.FinishedAt(),
+	}
+}
+
+func (g *GameResult) ToJSON() *GameResultJSON {
+	missed := make([]string, 0, len(g.Missed))
+	for _, letter := range g.Missed {
+		missed = append(missed, string(letter))
+	}
+
+	return &GameResultJSON{
+		Word:       g.Word,
+		Category:   g.Category,
+		Difficulty: g.Difficulty,
+		Mistakes:   g.Mistakes,
+		Attempts:   g.Attempts,
+		Duration:   g.Duration.String(),
+		Won:        g.Won,
+		Missed:     missed,
+		FinishedAt: g.FinishedAt,
+	}
+}
+
+func (g *GameResult) LogValue() slog.Value {
+	return slog.GroupValue(
+		SecretAttr("word", g.Word),
+		slog.String("category", g.Category),
+		slog.String("difficulty", g.Difficulty),
+		slog.Int("mistakes", g.Mistakes),
+		slog.Int("attempts", g.Attempts),
+		slog.Duration("duration", g.Duration),
+		slog.Bool("won", g.Won),
+	)
+}
+
+type ProfileJSON struct {
+	Version int              `json:"version"`
+	Name    string           `json:"name"`
+	Games   []GameResultJSON `json:"games"`
+	// History is keyed by the collection the words are played from.
+	History map[string]WordHistoryJSON `json:"history,omitempty"`
+	Daily   []DailyResultJSON          `json:"daily,omitempty"`
+}
+
+func (p *ProfileJSON) ToDomain() (profile *Profile, err error) {
+	if p.Version != ProfileVersion {
+		return nil, &BadProfileError{Message: fmt.Sprintf("unsupported version %d", p.Version)}
+	}
+
+	if err := ValidateProfileName(p.Name); err != nil {
+		return nil, err
+	}
+
+	profile = &Profile{Name: p.Name, Games: make([]GameResult, 0, len(p.Games))}
+
+	for i := range p.Games {
+		result, err := p.Games[i].ToDomain()
+		if err != nil {
+			return nil, err
+		}
+
+		profile.Games = append(profile.Games, *result)
+	}
+
+	for collection, history := range p.History {
+		profile.SetWordHistory(collection, history.ToDomain())
+	}
+
+	for i := range p.Daily {
+		profile.SetDailyResult(p.Daily[i].ToDomain())
+	}
+
+	return profile, nil
+}
+
+// Profile keeps the finished games of a player in the order they are played.
+type Profile struct {
+	Name    string
+	Games   []GameResult
+	History map[string]*WordHistory
+	// Daily are the daily challenges the player started.
+	Daily []DailyResult
+}
+
+func (p *Profile) AddGame(result *GameResult) {
+	p.Games = append(p.Games, *result)
+}
+
+// WordHistory returns an empty history for a collection no words are played from yet.
+func (p *Profile) WordHistory(collection string) *WordHistory {
+	if history, ok := p.History[collection]; ok {
+		return history
+	}
+
+	return &WordHistory{}
+}
+
+func (p *Profile) SetWordHistory(collection string, history *WordHistory) {
+	if p.History == nil {
+		p.History = make(map[string]*WordHistory)
+	}
+
+	p.History[collection] = history
+}
+
+// DailyResult returns nil when the challenge of the date is not played from the collection.
+func (p *Profile) DailyResult(date, collection string) *DailyResult {
+	for i := range p.Daily {
+		if p.Daily[i].Date == date && p.Daily[i].Collection == collection {
+			return &p.Daily[i]
+		}
+	}
+
+	return nil
+}
+
+func (p *Profile) SetDailyResult(result *DailyResult) {
+	if played := p.DailyResult(result.Date, result.Collection); played != nil {
+		*played = *result
+		return
+	}
+
+	p.Daily = append(p.Daily, *result)
+}
+
+func (p *Profile) ToJSON() *ProfileJSON {
+	games := make([]GameResultJSON, 0, len(p.Games))
+	for i := range p.Games {
+		games = append(games, *p.Games[i].ToJSON())
+	}
+
+	profile := &ProfileJSON{Version: ProfileVersion, Name: p.Name, Games: games}
+
+	for collection, history := range p.History {
+		if profile.History == nil {
+			profile.History = make(map[string]WordHistoryJSON)
+		}
+
+		profile.History[collection] = *history.ToJSON()
+	}
+
+	for i := range p.Daily {
+		profile.Daily = append(profile.Daily, *p.Daily[i].ToJSON())
+	}
+
+	return profile
+}
+
+func (p *Profile) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("name", p.Name),
+		slog.Int("games", len(p.Games)),
+	)
+}
+
+type WinRate struct {
+	Games int
+	Wins  int
+}
+
+func (w WinRate) Percent() float64 {
+	if w.Games == 0 {
+		return 0
+	}
+
+	return 100 * float64(w.Wins) / float64(w.Games)
+}
+
+type LetterCount struct {
+	Letter rune
+	Count  int
+}
+
+type Stats struct {
+	Total      WinRate
+	ByCategory map[string]WinRate
+	// ByDifficulty is keyed by the names of the levels.
+	ByDifficulty map[string]WinRate
+	// CurrentStreak is the number of games won in a row up to the last one.
+	CurrentStreak int
+	BestStreak    int
+	// MostMissed are the letters guessed wrong most often, at most MostMissedLetters of them.
+	MostMissed []LetterCount
+}
+
+func (p *Profile) Stats() *Stats {
+	stats := &Stats{ByCategory: make(map[string]WinRate), ByDifficulty: make(map[string]WinRate)}
+	missed := make(map[rune]int)
+
+	for i := range p.Games {
+		result := &p.Games[i]
+
+		stats.Total = stats.Total.add(result.Won)
+		stats.ByCategory[result.Category] = stats.ByCategory[result.Category].add(result.Won)
+		stats.ByDifficulty[result.Difficulty] = stats.ByDifficulty[result.Difficulty].add(result.Won)
+
+		if result.Won {
+			stats.CurrentStreak++
+			stats.BestStreak = max(stats.BestStreak, stats.CurrentStreak)
+		} else {
+			stats.CurrentStreak = 0
+		}
+
+		for _, letter := range result.Missed {
+			missed[letter]++
+		}
+	}
+
+	for letter, count := range missed {
+		stats.MostMissed = append(stats.MostMissed, LetterCount{Letter: letter, Count: count})
+	}
+
+	slices.SortFunc(stats.MostMissed, func(a, b LetterCount) int {
+		return cmp.Or(b.Count-a.Count, cmp.Compare(a.Letter, b.Letter))
+	})
+
+	stats.MostMissed = stats.MostMissed[:min(len(stats.MostMissed), MostMissedLetters)]
+
+	return stats
+}
+
+func (w WinRate) add(won bool) WinRate {
+	w.Games++
+
+	if won {
+		w.Wins++
+	}
+
+	return w
+}
+
+type BadProfileError struct {
+	Message string
+}
+
+func (e *BadProfileError) Error() string {
+	return fmt.Sprintf("bad profile: %s", e.Message)
+}
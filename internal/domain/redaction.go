@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"log/slog"
+	"sync/atomic"
+)
+
+const RedactedSecret = "[redacted]"
+
+// logSecrets reveals secret words and hints in logs, it is meant only for debugging.
+var logSecrets atomic.Bool
+
+func SetLogSecrets(enabled bool) {
+	logSecrets.Store(enabled)
+}
+
+func RedactSecret(secret string) string {
+	if secret == "" || logSecrets.Load() {
+		return secret
+	}
+
+	return RedactedSecret
+}
+
+func SecretAttr(key, secret string) slog.Attr {
+	return slog.String(key, RedactSecret(secret))
+}
@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+)
+
+// SavedGameVersion is bumped whenever the format of saved games changes, so binaries refuse games of another format
+// instead of misreading them.
+const SavedGameVersion = 1
+
+type SavedGameJSON struct {
+	Version    int    `json:"version"`
+	Category   string `json:"category"`
+	Difficulty string `json:"difficulty"`
+	// Levels are kept only for the collections that declare their own levels.
+	Levels []DifficultyLevelJSON `json:"levels,omitempty"`
+	GameJSON
+	// Events may be omitted, the game is then restored from the state.
+	Events []EventJSON `json:"events,omitempty"`
+}
+
+func (s *SavedGameJSON) ToDomain() (savedGame *SavedGame, err error) {
+	if s.Version != SavedGameVersion {
+		return nil, &BadSavedGameError{Message: fmt.Sprintf("unsupported version %d", s.Version)}
+	}
+
+	levels, err := NewDifficultyLevels(s.Levels)
+	if err != nil {
+		return nil, &BadSavedGameError{Message: err.Error()}
+	}
+
+	// Words typed in by a setter have no difficulty, so "unknown" is a valid value
+	difficulty := levels.Parse(s.Difficulty)
+	if difficulty == UnknownDifficulty && s.Difficulty != "unknown" {
+		return nil, &BadSavedGameError{Message: fmt.Sprintf("unknown difficulty %q", s.Difficulty)}
+	}
+
+	game, err := s.GameJSON.ToDomain()
+	if err != nil {
+		return nil, &BadSavedGameError{Message: err.Error()}
+	}
+
+	if len(s.Events) > 0 {
+		game, err = s.replay(game)
+		if err != nil {
+			return nil, &BadSavedGameError{Message: err.Error()}
+		}
+	}
+
+	return &SavedGame{
+		Category:   s.Category,
+		Difficulty: difficulty,
+		Levels:     levels,
+		Game:       game,
+	}, nil
+}
+
+// replay rebuilds the game from the events, so the practice history is restored too.
+func (s *SavedGameJSON) replay(saved *Game) (game *Game, err error) {
+	events := make([]Event, 0, len(s.Events))
+
+	for i := range s.Events {
+		event, err := s.Events[i].ToDomain()
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, *event)
+	}
+
+	game, err = ReplayEvents(events, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// A saved game whose events do not lead to its state is corrupted
+	if !reflect.DeepEqual(game.ToJSON(), saved.ToJSON()) {
+		return nil, &BadEventError{Message: "events do not lead to the saved state"}
+	}
+
+	return game, nil
+}
+
+type SavedGame struct {
+	Category   string
+	Difficulty Difficulty
+	// Levels name the difficulty, they are saved with the game, so it is resumed with the levels it is played with.
+	// Empty levels are DefaultDifficultyLevels.
+	Levels DifficultyLevels
+	Game   *Game
+}
+
+func (s *SavedGame) ToJSON() *SavedGameJSON {
+	events := make([]EventJSON, 0, len(s.Game.events))
+	for i := range s.Game.events {
+		events = append(events, *s.Game.events[i].ToJSON())
+	}
+
+	savedGame := &SavedGameJSON{
+		Version:    SavedGameVersion,
+		Category:   s.Category,
+		Difficulty: s.Levels.Name(s.Difficulty),
+		GameJSON:   *s.Game.ToJSON(),
+		Events:     events,
+	}
+
+	if !s.Levels.IsDefault() {
+		savedGame.Levels = s.Levels.ToJSON()
+	}
+
+	return savedGame
+}
+
+func (s *SavedGame) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("category", s.Category),
+		slog.String("difficulty", s.Levels.Name(s.Difficulty)),
+		slog.Any("game", s.Game),
+	)
+}
+
+type BadSavedGameError struct {
+	Message string
+}
+
+func (e *BadSavedGameError) Error() string {
+	return fmt.Sprintf("bad saved game: %s", e.Message)
+}
@@ -13,3 +13,23 @@ const (
 )
 
 const StateCount = 6
+
+func stateByMistakes(mistakes, maxMistakes int) State {
+	prop := mistakes * StateCount / maxMistakes
+	switch prop {
+	case 1:
+		return Head
+	case 2:
+		return Body
+	case 3:
+		return LeftArm
+	case 4:
+		return RightArm
+	case 5:
+		return LeftLeg
+	case 6:
+		return RightLeg
+	default:
+		return Initial
+	}
+}
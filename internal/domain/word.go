@@ -1,26 +1,134 @@
 package domain
 
-import "log/slog"
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DefaultWordWeight is the weight of the words without one.
+const DefaultWordWeight = 1
+
+// tagPattern keeps tags usable in tag filters.
+var tagPattern = regexp.MustCompile(`^[\p{L}\p{N}_-]+$`)
 
 type WordJSON struct {
 	Word string `json:"word"`
 	Hint string `json:"hint"`
+	// Hints are ordered from vague to specific and go after Hint.
+	Hints   []string `json:"hints,omitempty"`
+	Weight  float64  `json:"weight,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+	Source  string   `json:"source,omitempty"`
+	AddedOn string   `json:"addedOn,omitempty"`
 }
 
 func (w *WordJSON) ToDomain() *Word {
-	return &Word{
-		Word: w.Word,
-		Hint: w.Hint,
+	word := &Word{Word: w.Word, Weight: w.Weight, Source: w.Source, AddedOn: w.AddedOn}
+
+	for _, tag := range w.Tags {
+		word.Tags = append(word.Tags, strings.ToLower(tag))
+	}
+
+	for _, hint := range append([]string{w.Hint}, w.Hints...) {
+		switch {
+		case hint == "":
+			continue
+		case word.Hint == "":
+			word.Hint = hint
+		default:
+			word.MoreHints = append(word.MoreHints, hint)
+		}
 	}
+
+	return word
 }
 
 type Word struct {
 	Word string
 	Hint string
+	// MoreHints are more specific than Hint.
+	MoreHints []string
+	// Weight makes the word chosen more or less often than other words, zero means DefaultWordWeight.
+	Weight float64
+	// Tags are in lower case.
+	Tags   []string
+	Source string
+	// AddedOn is the date the word is added to the collection in the time.DateOnly layout.
+	AddedOn string
+}
+
+func (w *Word) ToJSON() WordJSON {
+	return WordJSON{
+		Word:    w.Word,
+		Hint:    w.Hint,
+		Hints:   w.MoreHints,
+		Weight:  w.Weight,
+		Tags:    w.Tags,
+		Source:  w.Source,
+		AddedOn: w.AddedOn,
+	}
+}
+
+func (w *Word) SelectionWeight() float64 {
+	if w.Weight == 0 {
+		return DefaultWordWeight
+	}
+
+	return w.Weight
+}
+
+func (w *Word) HasTag(tag string) bool {
+	return slices.Contains(w.Tags, tag)
+}
+
+// Hints returns all hints of the word ordered from vague to specific.
+func (w *Word) Hints() []string {
+	if w.Hint == "" {
+		return nil
+	}
+
+	return append([]string{w.Hint}, w.MoreHints...)
 }
 
 func (w *Word) LogValue() slog.Value {
 	return slog.GroupValue(
-		slog.String("word", w.Word),
+		SecretAttr("word", w.Word),
+		SecretAttr("hint", w.Hint),
+		slog.Int("hints", len(w.Hints())),
 	)
 }
+
+// Validate uses LatinAlphabet when alphabet is nil.
+func (w *Word) Validate(alphabet *Alphabet) error {
+	if strings.TrimSpace(w.Word) == "" {
+		return &BadWordError{Message: "word is empty"}
+	}
+
+	if w.Weight < 0 {
+		return &BadWordError{Message: fmt.Sprintf("weight %v is negative", w.Weight)}
+	}
+
+	for _, tag := range w.Tags {
+		if !tagPattern.MatchString(tag) {
+			return &BadWordError{Message: fmt.Sprintf("tag %q must be letters, digits, '-' or '_'", tag)}
+		}
+	}
+
+	if _, err := time.Parse(time.DateOnly, w.AddedOn); w.AddedOn != "" && err != nil {
+		return &BadWordError{Message: fmt.Sprintf("added on %q is not a date like 2006-01-02", w.AddedOn)}
+	}
+
+	return orLatin(alphabet).ValidateWord(w.Word)
+}
+
+type BadWordError struct {
+	Message string
+}
+
+func (e *BadWordError) Error() string {
+	return fmt.Sprintf("bad word: %s", e.Message)
+}
@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Weights of the word difficulty scoring model.
+const (
+	lengthWeight    = 1.0
+	distinctWeight  = 1.5
+	rarityWeight    = 10.0
+	extraWordWeight = 3.0
+	// neutralRarity is given to letters missing from the frequencies, so they do not look rarer than the rarest
+	// known letters.
+	neutralRarity = 0.5
+)
+
+// EnglishLetterFrequencies are the frequencies of english letters in percent.
+var EnglishLetterFrequencies = NewLetterFrequencies(map[rune]float64{
+	'a': 8.2, 'b': 1.5, 'c': 2.8, 'd': 4.3, 'e': 12.7, 'f': 2.2, 'g': 2.0, 'h': 6.1, 'i': 7.0,
+	'j': 0.15, 'k': 0.77, 'l': 4.0, 'm': 2.4, 'n': 6.7, 'o': 7.5, 'p': 1.9, 'q': 0.095, 'r': 6.0,
+	's': 6.3, 't': 9.1, 'u': 2.8, 'v': 0.98, 'w': 2.4, 'x': 0.15, 'y': 2.0, 'z': 0.074,
+})
+
+// LetterFrequencies tell how common the letters of an alphabet are, words of rare letters are harder to guess.
+type LetterFrequencies struct {
+	frequencies map[rune]float64
+	max         float64
+}
+
+func NewLetterFrequencies(frequencies map[rune]float64) *LetterFrequencies {
+	letterFrequencies := &LetterFrequencies{frequencies: frequencies}
+	for _, frequency := range frequencies {
+		letterFrequencies.max = max(letterFrequencies.max, frequency)
+	}
+
+	return letterFrequencies
+}
+
+// CountLetterFrequencies counts the letters of the words themselves, so an alphabet without known frequencies
+// gets its rare letters too.
+func CountLetterFrequencies(words []string) *LetterFrequencies {
+	counts := make(map[rune]float64)
+	total := 0.0
+
+	for _, word := range words {
+		for _, letter := range strings.ToLower(word) {
+			if unicode.IsLetter(letter) {
+				counts[letter]++
+				total++
+			}
+		}
+	}
+
+	for letter := range counts {
+		counts[letter] = counts[letter] * 100 / total
+	}
+
+	return NewLetterFrequencies(counts)
+}
+
+// Covers tells whether every letter of the alphabet has a frequency.
+func (f *LetterFrequencies) Covers(alphabet *Alphabet) bool {
+	for _, letter := range orLatin(alphabet).Letters() {
+		if _, ok := f.frequencies[letter]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// rarity is 0 for the most common letter and 1 for letters that almost never occur.
+func (f *LetterFrequencies) rarity(letter rune) float64 {
+	frequency, ok := f.frequencies[letter]
+	if !ok || f.max == 0 {
+		return neutralRarity
+	}
+
+	return 1 - frequency/f.max
+}
+
+// WordDifficultyScore grows with the word length, the number of distinct letters,
+// the average rarity of those letters and the number of words in a phrase.
+// Nil frequencies are EnglishLetterFrequencies, the letters of other alphabets are all of neutral rarity then.
+func WordDifficultyScore(word string, frequencies *LetterFrequencies) float64 {
+	if frequencies == nil {
+		frequencies = EnglishLetterFrequencies
+	}
+
+	word = strings.ToLower(word)
+	length, rarity := 0, 0.0
+	distinct := make(map[rune]bool)
+
+	for _, letter := range word {
+		if !unicode.IsLetter(letter) {
+			continue
+		}
+
+		length++
+
+		if !distinct[letter] {
+			distinct[letter] = true
+			rarity += frequencies.rarity(letter)
+		}
+	}
+
+	if len(distinct) == 0 {
+		return 0
+	}
+
+	rarity /= float64(len(distinct))
+	extraWords := len(strings.Fields(word)) - 1
+
+	return lengthWeight*float64(length) + distinctWeight*float64(len(distinct)) +
+		rarityWeight*rarity + extraWordWeight*float64(extraWords)
+}
+
+// ClassifyWords splits the words into equal by size buckets for the levels ordered by their difficulty score,
+// so every difficulty of the category gets words just like in a hand-bucketed one.
+func ClassifyWords(words []Word, levels int, frequencies *LetterFrequencies) (wordsByLevel [][]Word) {
+	sorted := make([]Word, len(words))
+	copy(sorted, words)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return WordDifficultyScore(sorted[i].Word, frequencies) < WordDifficultyScore(sorted[j].Word, frequencies)
+	})
+
+	wordsByLevel = make([][]Word, 0, levels)
+
+	for level := range levels {
+		// The remainder goes to the easier buckets
+		count := (len(sorted) + levels - level - 1) / (levels - level)
+
+		wordsByLevel = append(wordsByLevel, append(make([]Word, 0, count), sorted[:count]...))
+		sorted = sorted[count:]
+	}
+
+	return wordsByLevel
+}
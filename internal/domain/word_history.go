@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxRecentWords is how many played words a history keeps, so it is the most games the recent policy avoids.
+	MaxRecentWords     = 100
+	DefaultRecentGames = 10
+)
+
+type RepeatMode int
+
+const (
+	AnyWord RepeatMode = iota
+	// AvoidRecent does not choose the words played in the last games.
+	AvoidRecent
+	// ShuffledDeck plays every word of a list once in a shuffled order before any word is repeated, so weights are not used.
+	ShuffledDeck
+)
+
+// RepeatPolicy decides whether a word played before may be chosen again.
+type RepeatPolicy struct {
+	Mode RepeatMode
+	// Recent is the number of last games whose words are avoided in the AvoidRecent mode.
+	Recent int
+}
+
+// DefaultRepeatPolicy chooses words uniformly as it was done before the word history was introduced.
+var DefaultRepeatPolicy = RepeatPolicy{Mode: AnyWord}
+
+// ParseRepeatPolicy accepts any, recent[:N] and deck.
+func ParseRepeatPolicy(value string) (policy RepeatPolicy, err error) {
+	name, arg, hasArg := strings.Cut(strings.ToLower(strings.TrimSpace(value)), ":")
+
+	games := DefaultRecentGames
+
+	if hasArg {
+		games, err = strconv.Atoi(arg)
+		if err != nil || games < 1 || games > MaxRecentWords {
+			return RepeatPolicy{}, &BadRepeatPolicyError{
+				Message: fmt.Sprintf("%q is not a number of games from 1 to %d", arg, MaxRecentWords),
+			}
+		}
+	}
+
+	switch {
+	case name == "any" && !hasArg:
+		return RepeatPolicy{Mode: AnyWord}, nil
+	case name == "recent":
+		return RepeatPolicy{Mode: AvoidRecent, Recent: games}, nil
+	case name == "deck" && !hasArg:
+		return RepeatPolicy{Mode: ShuffledDeck}, nil
+	default:
+		return RepeatPolicy{}, &BadRepeatPolicyError{Message: fmt.Sprintf("unknown policy %q", value)}
+	}
+}
+
+func (r RepeatPolicy) String() string {
+	switch r.Mode {
+	case AvoidRecent:
+		return fmt.Sprintf("recent:%d", r.Recent)
+	case ShuffledDeck:
+		return "deck"
+	default:
+		return "any"
+	}
+}
+
+// WordListKey names a list of words of a collection in its history by the category and the name of the level.
+func WordListKey(category, level string) string {
+	return category + "/" + level
+}
+
+type WordHistoryJSON struct {
+	Recent []string            `json:"recent,omitempty"`
+	Decks  map[string][]string `json:"decks,omitempty"`
+}
+
+func (w *WordHistoryJSON) ToDomain() *WordHistory {
+	return &WordHistory{Recent: w.Recent, Decks: w.Decks}
+}
+
+// WordHistory keeps the words played from a collection.
+type WordHistory struct {
+	// Recent are the last played words, the latest is the last one.
+	Recent []string
+	// Decks are the words left to play from every list in the ShuffledDeck mode, keyed by WordListKey.
+	Decks map[string][]string
+}
+
+func (w *WordHistory) ToJSON() *WordHistoryJSON {
+	return &WordHistoryJSON{Recent: w.Recent, Decks: w.Decks}
+}
+
+// Choose picks a word of the list by the policy and remembers it.
+func (w *WordHistory) Choose(words []Word, list string, policy RepeatPolicy, random *rand.Rand) (word *Word, err error) {
+	if len(words) == 0 {
+		return nil, &BadCategoryError{Message: "words list for chosen category and difficulty is empty"}
+	}
+
+	switch policy.Mode {
+	case AvoidRecent:
+		word = w.chooseNotRecent(words, policy.Recent, random)
+	case ShuffledDeck:
+		word = w.drawFromDeck(words, list, random)
+	default:
+		word = ChooseWeighted(words, random)
+	}
+
+	w.Recent = append(w.Recent, word.Word)
+	w.Recent = w.Recent[max(0, len(w.Recent)-MaxRecentWords):]
+
+	return word, nil
+}
+
+func (w *WordHistory) chooseNotRecent(words []Word, games int, random *rand.Rand) *Word {
+	recent := w.Recent[max(0, len(w.Recent)-games):]
+	candidates := make([]*Word, 0, len(words))
+
+	for i := range words {
+		if !slices.Contains(recent, words[i].Word) {
+			candidates = append(candidates, &words[i])
+		}
+	}
+
+	// When every word of the list is recent the one played longest ago is chosen
+	if len(candidates) == 0 {
+		return w.leastRecent(words)
+	}
+
+	return chooseWeighted(candidates, random)
+}
+
+func (w *WordHistory) leastRecent(words []Word) *Word {
+	oldest, oldestPlayed := &words[0], len(w.Recent)
+
+	for i := range words {
+		if played := w.lastPlayed(words[i].Word); played < oldestPlayed {
+			oldest, oldestPlayed = &words[i], played
+		}
+	}
+
+	return oldest
+}
+
+// lastPlayed returns the index of the word in the recent words, it is -1 for words not played recently.
+func (w *WordHistory) lastPlayed(word string) int {
+	for i := len(w.Recent) - 1; i >= 0; i-- {
+		if w.Recent[i] == word {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (w *WordHistory) drawFromDeck(words []Word, list string, random *rand.Rand) *Word {
+	byWord := make(map[string]*Word, len(words))
+	for i := range words {
+		byWord[words[i].Word] = &words[i]
+	}
+
+	// Words removed from the list since the deck was shuffled are skipped
+	deck := slices.DeleteFunc(slices.Clone(w.Decks[list]), func(word string) bool {
+		return byWord[word] == nil
+	})
+
+	if len(deck) == 0 {
+		deck = make([]string, 0, len(words))
+		for i := range words {
+			deck = append(deck, words[i].Word)
+		}
+
+		random.Shuffle(len(deck), func(i, j int) {
+			deck[i], deck[j] = deck[j], deck[i]
+		})
+
+		// The next round of the deck does not start with the word just played
+		if len(deck) > 1 && len(w.Recent) > 0 && deck[0] == w.Recent[len(w.Recent)-1] {
+			deck[0], deck[len(deck)-1] = deck[len(deck)-1], deck[0]
+		}
+
+		slog.Info("Word deck shuffled", slog.String("list", list), slog.Int("words", len(deck)))
+	}
+
+	if w.Decks == nil {
+		w.Decks = make(map[string][]string)
+	}
+
+	w.Decks[list] = deck[1:]
+
+	return byWord[deck[0]]
+}
+
+type BadRepeatPolicyError struct {
+	Message string
+}
+
+func (e *BadRepeatPolicyError) Error() string {
+	return fmt.Sprintf("bad repeat policy: %s", e.Message)
+}
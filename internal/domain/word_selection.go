@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// TagFilter keeps the words that have all the Required tags and none of the Excluded ones.
+type TagFilter struct {
+	Required []string
+	Excluded []string
+}
+
+// ParseTagFilter accepts tags joined by "+", a tag with a leading "!" is excluded, e.g. "animals+mammal+!obscure".
+func ParseTagFilter(value string) (filter TagFilter, err error) {
+	if strings.TrimSpace(value) == "" {
+		return TagFilter{}, nil
+	}
+
+	for _, tag := range strings.Split(strings.ToLower(value), "+") {
+		tag = strings.TrimSpace(tag)
+
+		excluded := strings.HasPrefix(tag, "!")
+		tag = strings.TrimPrefix(tag, "!")
+
+		if !tagPattern.MatchString(tag) {
+			return TagFilter{}, &BadTagFilterError{Message: fmt.Sprintf("%q is not a tag in %q", tag, value)}
+		}
+
+		if excluded {
+			filter.Excluded = append(filter.Excluded, tag)
+		} else {
+			filter.Required = append(filter.Required, tag)
+		}
+	}
+
+	return filter, nil
+}
+
+func (t *TagFilter) String() string {
+	tags := append([]string{}, t.Required...)
+	for _, tag := range t.Excluded {
+		tags = append(tags, "!"+tag)
+	}
+
+	return strings.Join(tags, "+")
+}
+
+func (t *TagFilter) Set(value string) (err error) {
+	*t, err = ParseTagFilter(value)
+
+	return err
+}
+
+func (t *TagFilter) IsEmpty() bool {
+	return len(t.Required) == 0 && len(t.Excluded) == 0
+}
+
+func (t *TagFilter) Matches(word *Word) bool {
+	for _, tag := range t.Required {
+		if !word.HasTag(tag) {
+			return false
+		}
+	}
+
+	for _, tag := range t.Excluded {
+		if word.HasTag(tag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t *TagFilter) Filter(words []Word) []Word {
+	if t.IsEmpty() {
+		return words
+	}
+
+	filtered := make([]Word, 0, len(words))
+
+	for i := range words {
+		if t.Matches(&words[i]) {
+			filtered = append(filtered, words[i])
+		}
+	}
+
+	return filtered
+}
+
+// ChooseWeighted picks a word with a probability proportional to its weight.
+func ChooseWeighted(words []Word, random *rand.Rand) *Word {
+	candidates := make([]*Word, 0, len(words))
+	for i := range words {
+		candidates = append(candidates, &words[i])
+	}
+
+	return chooseWeighted(candidates, random)
+}
+
+func chooseWeighted(words []*Word, random *rand.Rand) *Word {
+	total := 0.0
+	for _, word := range words {
+		total += word.SelectionWeight()
+	}
+
+	point := random.Float64() * total
+
+	for _, word := range words {
+		point -= word.SelectionWeight()
+		if point < 0 {
+			return word
+		}
+	}
+
+	// Rounding may leave the point right at the total
+	return words[len(words)-1]
+}
+
+type BadTagFilterError struct {
+	Message string
+}
+
+func (e *BadTagFilterError) Error() string {
+	return fmt.Sprintf("bad tag filter: %s", e.Message)
+}
@@ -1,40 +1,118 @@
 package domain
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log/slog"
 )
 
 type WordsCollectionJSON struct {
-	Creator     string         `json:"creator"`
-	Description string         `json:"description"`
-	Categories  []CategoryJSON `json:"categories"`
+	Creator     string        `json:"creator"`
+	Description string        `json:"description"`
+	Alphabet    *AlphabetJSON `json:"alphabet"`
+	// Normalization is declared per collection, so lists with accented words can be played on an ASCII keyboard.
+	Normalization *NormalizationJSON `json:"normalization"`
+	// Levels are ordered from the easiest, DefaultDifficultyLevels are used when there are none.
+	Levels     []DifficultyLevelJSON `json:"levels"`
+	Categories []CategoryJSON        `json:"categories"`
 }
 
-func (w *WordsCollectionJSON) ToDomain() *WordsCollection {
+func (w *WordsCollectionJSON) ToDomain() (wordsCollection *WordsCollection, err error) {
+	alphabet, err := w.Alphabet.ToDomain()
+	if err != nil {
+		return nil, fmt.Errorf("convert alphabet: %w", err)
+	}
+
+	normalization, err := w.Normalization.ToDomain()
+	if err != nil {
+		return nil, fmt.Errorf("convert normalization: %w", err)
+	}
+
+	alphabet = alphabet.WithNormalization(normalization)
+
+	levels, err := NewDifficultyLevels(w.Levels)
+	if err != nil {
+		return nil, fmt.Errorf("convert levels: %w", err)
+	}
+
+	if err := levels.Validate(alphabet); err != nil {
+		return nil, fmt.Errorf("validate levels: %w", err)
+	}
+
 	categories := make([]Category, 0, len(w.Categories))
+	frequencies := w.letterFrequencies(alphabet)
+
+	for _, categoryJSON := range w.Categories {
+		category, err := categoryJSON.ToDomain(levels, frequencies)
+		if err != nil {
+			return nil, fmt.Errorf("convert category %q: %w", categoryJSON.Name, err)
+		}
+
+		category.Alphabet = alphabet
+
+		if err := category.Validate(levels); err != nil {
+			return nil, fmt.Errorf("validate category %q: %w", category.Name, err)
+		}
 
-	for _, category := range w.Categories {
-		categories = append(categories, *category.ToDomain())
+		categories = append(categories, *category)
 	}
 
 	return &WordsCollection{
 		Creator:     w.Creator,
 		Description: w.Description,
+		Alphabet:    alphabet,
+		Levels:      levels,
 		Categories:  categories,
+	}, nil
+}
+
+// letterFrequencies are counted from the words of the collection when the english frequencies miss letters of its alphabet.
+func (w *WordsCollectionJSON) letterFrequencies(alphabet *Alphabet) *LetterFrequencies {
+	if EnglishLetterFrequencies.Covers(alphabet) {
+		return EnglishLetterFrequencies
+	}
+
+	words := make([]string, 0)
+	for i := range w.Categories {
+		words = append(words, w.Categories[i].words()...)
 	}
+
+	return CountLetterFrequencies(words)
 }
 
 type WordsCollection struct {
 	Creator     string
 	Description string
+	Alphabet    *Alphabet
+	Levels      DifficultyLevels
 	Categories  []Category
 }
 
+// ContentHash identifies the words of the collection, so everyone playing the same words gets the same daily challenge.
+func (w *WordsCollection) ContentHash() string {
+	hash := sha256.New()
+
+	for i := range w.Categories {
+		category := &w.Categories[i]
+		fmt.Fprintf(hash, "category %q\n", category.Name)
+
+		for difficulty, words := range category.WordsByLevel {
+			for _, word := range words {
+				fmt.Fprintf(hash, "%d %q\n", difficulty, word.Word)
+			}
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
 func (w *WordsCollection) LogValue() slog.Value {
 	return slog.GroupValue(
 		slog.String("creator", w.Creator),
 		slog.String("description", w.Description),
+		slog.Any("alphabet", w.Alphabet),
+		slog.Any("levels", w.Levels),
 		slog.Int("categories count", len(w.Categories)),
 	)
 }
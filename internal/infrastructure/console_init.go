@@ -1,32 +1,294 @@
 package infrastructure
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"log/slog"
+	"math/rand/v2"
+	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"makly/hangman/internal/application"
 	"makly/hangman/internal/domain"
 	"makly/hangman/pkg/climenu"
 )
 
-func InitFlagsParameters() (path string, difficulty domain.Difficulty, maxMistakes int) {
-	flag.StringVar(&path, "path", "", "path to json file with words collection")
-	flag.Var(&difficulty, "difficulty", "difficulty level: easy, medium, hard")
-	flag.IntVar(&maxMistakes, "maxmistakes", domain.StateCount, "maximum number of mistakes: integer from 1 to 26; default value: 6")
-	difficulty = domain.UnknownDifficulty
+const SetterCategoryName = "Setter's word"
 
-	flag.Parse()
+const (
+	SolveCommand    = "solve"
+	SimulateCommand = "simulate"
+	ReplayCommand   = "replay"
+	StatsCommand    = "stats"
+	DailyCommand    = "daily"
+)
+
+type FlagsParameters struct {
+	Command string
+	// ReplayPath is the argument of the replay command, e.g. "hangman replay records/game.json"
+	ReplayPath string
+	Path       string
+	// Difficulty is a name of a level, it is parsed when the levels of the collection are known.
+	Difficulty string
+	// MaxMistakes is zero when the maxMistakes of the difficulty level is used.
+	MaxMistakes  int
+	Resume       bool
+	Rounds       int
+	Players      string
+	MistakesRule domain.MistakesRule
+	Evil         bool
+	Setter       bool
+	Practice     bool
+	Opponent     bool
+	Runs         int
+	Format       ReportFormat
+	HintPolicy   HintPolicyFlag
+	GuessTime    time.Duration
+	GameTime     time.Duration
+	Profile      string
+	Seed         SeedFlag
+	Tags         domain.TagFilter
+}
+
+// SeedFlag keeps whether the seed is set, so a random seed is chosen otherwise.
+type SeedFlag struct {
+	Seed  uint64
+	IsSet bool
+}
+
+func (s *SeedFlag) String() string {
+	if !s.IsSet {
+		return ""
+	}
+
+	return strconv.FormatUint(s.Seed, 10)
+}
+
+func (s *SeedFlag) Set(value string) (err error) {
+	s.Seed, err = strconv.ParseUint(value, 10, 64)
+	s.IsSet = err == nil
+
+	return err
+}
+
+// HintPolicyFlag keeps a nil policy when the flag is not set, so the configured policies are used.
+type HintPolicyFlag struct {
+	Policy domain.HintPolicy
+}
+
+func (h *HintPolicyFlag) String() string {
+	if h.Policy == nil {
+		return ""
+	}
+
+	return h.Policy.String()
+}
+
+func (h *HintPolicyFlag) Set(value string) (err error) {
+	h.Policy, err = domain.ParseHintPolicy(value)
+
+	return err
+}
+
+// ParseHintPolicies builds the policies from the config, difficulties without a policy use the policy of their level
+// and then defaultPolicy.
+func ParseHintPolicies(
+	defaultPolicy string,
+	byDifficulty map[string]string,
+	levels domain.DifficultyLevels,
+) (policies *domain.HintPolicies, err error) {
+	policies = &domain.HintPolicies{
+		Default:      domain.DefaultHintPolicy,
+		ByDifficulty: make(map[domain.Difficulty]domain.HintPolicy),
+		Levels:       levels,
+	}
+
+	if defaultPolicy != "" {
+		policies.Default, err = domain.ParseHintPolicy(defaultPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("parse default hint policy: %w", err)
+		}
+	}
+
+	for name, value := range byDifficulty {
+		difficulty := levels.Parse(name)
+		if difficulty == domain.UnknownDifficulty {
+			return nil, &domain.BadDifficultyError{Message: fmt.Sprintf("unknown difficulty %q of hint policy", name)}
+		}
+
+		policies.ByDifficulty[difficulty], err = domain.ParseHintPolicy(value)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s hint policy: %w", name, err)
+		}
+	}
+
+	for i := range levels {
+		if _, ok := policies.ByDifficulty[domain.Difficulty(i)]; !ok && levels[i].HintPolicy != nil {
+			policies.ByDifficulty[domain.Difficulty(i)] = levels[i].HintPolicy
+		}
+	}
+
+	return policies, nil
+}
+
+type Settings struct {
+	Command    string
+	Categories []domain.Category
+	Alphabet   *domain.Alphabet
+	// In match mode Category is nil and Difficulty is domain.UnknownDifficulty
+	// when they have to be chosen randomly for every round, domain.AdaptiveDifficulty follows the results of the rounds.
+	Category   *domain.Category
+	Difficulty domain.Difficulty
+	// Levels are the difficulty levels of the collection or of the saved game.
+	Levels      domain.DifficultyLevels
+	MaxMistakes int
+	Rounds      int
+	SavedGame   *domain.SavedGame
+	// Players are set only in hot seat mode.
+	Players      []string
+	MistakesRule domain.MistakesRule
+	// SetterWord is set when the secret word is typed in by another player instead of being chosen from the collection.
+	SetterWord bool
+	// Evil is set when the secret word is not fixed and dodges the guesses.
+	Evil bool
+	// Practice is set only in single games, because taking guesses back does not fit scored and competitive modes.
+	Practice bool
+	// Opponent is set only in practice games, the solver plays the word after the player.
+	Opponent bool
+	// Runs and Format are used only by the simulate command.
+	Runs   int
+	Format ReportFormat
+	// HintPolicy is set by the -hints flag for every difficulty, nil means the configured policies.
+	HintPolicy domain.HintPolicy
+	TimeLimits domain.TimeLimits
+	// Profile is the name of the player profile, the profile is not used when the name is not valid.
+	Profile string
+	// CollectionPath is the absolute path of the words collection, the word history of a profile is kept by it.
+	CollectionPath string
+	// CollectionHash is the content hash of the words collection, the daily challenge is chosen by it.
+	CollectionHash string
+	// Random is the source of every random choice of the session, it is seeded by the -seed flag.
+	Random *rand.Rand
+	// Tags filter the words chosen from the collection.
+	Tags domain.TagFilter
+}
+
+func (s *Settings) IsMatch() bool {
+	return s.Rounds > 1
+}
+
+func (s *Settings) IsHotSeat() bool {
+	return len(s.Players) > 0
+}
+
+func InitFlagsParameters() *FlagsParameters {
+	params := &FlagsParameters{}
+
+	flag.StringVar(&params.Path, "path", "", "path to json file with words collection")
+	flag.StringVar(&params.Difficulty, "difficulty", "",
+		"difficulty level: easy, medium, hard or a level of the collection; adaptive follows your results in a match")
+	flag.IntVar(&params.MaxMistakes, "maxmistakes", 0,
+		"maximum number of mistakes: from 1 to the number of letters in the alphabet; default value: from the level or 6")
+	flag.BoolVar(&params.Resume, "resume", false, "resume the last interrupted game")
+	flag.IntVar(&params.Rounds, "rounds", 1, "number of rounds in a match; default value: 1 (single game)")
+	flag.StringVar(&params.Players, "players", "", "comma separated names of 2-8 hot seat players")
+	flag.BoolVar(&params.Evil, "evil", false, "evil hangman: the secret word dodges your guesses while it can")
+	flag.BoolVar(&params.Setter, "setter", false, "two players: the setter types a secret word, the guesser guesses it; single games only")
+	flag.BoolVar(&params.Practice, "practice", false, "practice game: guesses can be taken back; single games only")
+	flag.BoolVar(&params.Opponent, "opponent", false, "the solver plays the word of a practice game after you; practice games only")
+	flag.Var(&params.MistakesRule, "mistakesrule", "hot seat mistakes budget: shared, personal; default value: shared")
+	flag.IntVar(&params.Runs, "runs", application.DefaultSimulationRuns, "solver runs per word in simulate command; default value: 100")
+	flag.Var(&params.Format, "format", "simulate command report format: json, csv; default value: json")
+	flag.DurationVar(&params.GuessTime, "guesstime", 0, "time limit for every guess, e.g. 30s; a timed out guess is a mistake")
+	flag.DurationVar(&params.GameTime, "gametime", 0, "time limit for the whole game, e.g. 5m; a timed out game is lost")
+	flag.StringVar(&params.Profile, "profile", domain.DefaultProfileName, "player profile that keeps the statistics of finished games")
+	flag.Var(&params.Seed, "seed", "seed of every random choice, the same seed plays the same session; default value: random")
+	flag.Var(&params.Tags, "tags", "only words with the tags joined by +, a tag with a leading ! is excluded, e.g. animals+!obscure")
+	flag.Var(&params.HintPolicy, "hints", "hint policy: never, always, half, after:N, request:N, progressive; default value: from config")
+
+	// The command goes before flags, e.g. "hangman solve -path words.json"
+	args := os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		params.Command, args = args[0], args[1:]
+	}
+
+	// Errors are handled by the flag package itself because of flag.ExitOnError
+	_ = flag.CommandLine.Parse(args)
+
+	if params.Command == ReplayCommand {
+		params.ReplayPath = flag.Arg(0)
+	}
+
+	return params
+}
 
-	return path, difficulty, maxMistakes
+func (p *FlagsParameters) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("command", p.Command),
+		slog.String("replayPath", p.ReplayPath),
+		slog.String("path", p.Path),
+		slog.String("difficulty", p.Difficulty),
+		slog.Int("maxMistakes", p.MaxMistakes),
+		slog.Bool("resume", p.Resume),
+		slog.Int("rounds", p.Rounds),
+		slog.String("players", p.Players),
+		slog.String("mistakesRule", p.MistakesRule.String()),
+		slog.Bool("evil", p.Evil),
+		slog.Bool("setter", p.Setter),
+		slog.Bool("practice", p.Practice),
+		slog.Bool("opponent", p.Opponent),
+		slog.Int("runs", p.Runs),
+		slog.String("format", p.Format.String()),
+		slog.String("hints", p.HintPolicy.String()),
+		slog.Duration("guessTime", p.GuessTime),
+		slog.Duration("gameTime", p.GameTime),
+		slog.String("profile", p.Profile),
+		slog.String("seed", p.Seed.String()),
+		slog.String("tags", p.Tags.String()),
+	)
 }
 
-func ChooseDifficulty(menu climenu.MenuProvider) (difficulty domain.Difficulty, err error) {
+// parseDifficulty returns UnknownDifficulty for a name that is not a level of the collection, then it is chosen in the menu.
+func parseDifficulty(name string, levels domain.DifficultyLevels) (difficulty domain.Difficulty) {
+	switch name {
+	case "":
+		return domain.UnknownDifficulty
+	case "adaptive":
+		return domain.AdaptiveDifficulty
+	}
+
+	if difficulty = levels.Parse(name); difficulty == domain.UnknownDifficulty {
+		slog.Warn("Unknown difficulty level, choose difficulty in the menu", slog.String("difficulty", name))
+		return domain.UnknownDifficulty
+	}
+
+	return difficulty
+}
+
+func addDifficultyItems(levels domain.DifficultyLevels, menu climenu.MenuProvider) {
+	for level := range levels.Count() {
+		menu.AddItem(levels.Title(domain.Difficulty(level)))
+	}
+}
+
+func addCategoryItems(categories []domain.Category, menu climenu.MenuProvider) {
+	for _, category := range categories {
+		menu.AddItem(category.Name)
+	}
+}
+
+func ChooseDifficulty(
+	levels domain.DifficultyLevels,
+	menu climenu.MenuProvider,
+	random *rand.Rand,
+) (difficulty domain.Difficulty, err error) {
 	menu.AddItem("Secret difficulty (difficulty will be chosen randomly)")
-	menu.AddItem(domain.EasyDifficulty.String())
-	menu.AddItem(domain.MediumDifficulty.String())
-	menu.AddItem(domain.HardDifficulty.String())
+	addDifficultyItems(levels, menu)
 
 	slog.Info("Start choose difficulty menu", slog.Any("menu", menu))
 
@@ -36,27 +298,21 @@ func ChooseDifficulty(menu climenu.MenuProvider) (difficulty domain.Difficulty,
 	}
 
 	if chosenIndex == 0 {
-		difficulty, err := application.ChoiceDifficulty()
-		if err != nil {
-			return domain.UnknownDifficulty, fmt.Errorf("random choose difficulty: %w", err)
-		}
+		difficulty := application.ChoiceDifficulty(levels, random)
 
-		slog.Info("Random chosen difficulty", slog.String("difficulty", difficulty.String()))
+		slog.Info("Random chosen difficulty", slog.String("difficulty", levels.Name(difficulty)))
 
 		return difficulty, nil
 	}
 
-	slog.Info("Chosen difficulty", slog.String("difficulty", domain.Difficulty(chosenIndex).String()))
+	slog.Info("Chosen difficulty", slog.String("difficulty", levels.Name(domain.Difficulty(chosenIndex-1))))
 
 	return domain.Difficulty(chosenIndex - 1), nil
 }
 
-func ChooseCategory(categories []domain.Category, menu climenu.MenuProvider) (category *domain.Category, err error) {
+func ChooseCategory(categories []domain.Category, menu climenu.MenuProvider, random *rand.Rand) (category *domain.Category, err error) {
 	menu.AddItem("Secret category (category will be chosen randomly)")
-
-	for _, category := range categories {
-		menu.AddItem(category.Name)
-	}
+	addCategoryItems(categories, menu)
 
 	slog.Info("Start choose category menu", slog.Any("menu", menu))
 
@@ -66,7 +322,7 @@ func ChooseCategory(categories []domain.Category, menu climenu.MenuProvider) (ca
 	}
 
 	if chosenIndex == 0 {
-		category, err = application.ChoiceCategory(categories)
+		category, err = application.ChoiceCategory(categories, random)
 		if err != nil {
 			return nil, fmt.Errorf("random choose category: %w", err)
 		}
@@ -81,47 +337,354 @@ func ChooseCategory(categories []domain.Category, menu climenu.MenuProvider) (ca
 	return &categories[chosenIndex-1], nil
 }
 
-func Init(defaultSamplePath, schemaPath string) (category *domain.Category, difficulty domain.Difficulty, maxMistakes int, err error) {
-	jsonAbsPath, difficulty, maxMistakes := InitFlagsParameters()
-	if jsonAbsPath == "" {
-		jsonAbsPath, err = filepath.Abs(defaultSamplePath)
+// ChooseGameCategory offers the setter's word next to the categories, then the secret word is typed in by another player.
+func ChooseGameCategory(
+	categories []domain.Category,
+	menu climenu.MenuProvider,
+	random *rand.Rand,
+) (category *domain.Category, setterWord bool, err error) {
+	menu.AddItem("Secret category (category will be chosen randomly)")
+	addCategoryItems(categories, menu)
+	menu.AddItem("Setter's word (another player types the secret word, you guess it)")
+
+	slog.Info("Start choose game category menu", slog.Any("menu", menu))
+
+	chosenIndex, err := menu.RunMenu()
+	if err != nil {
+		return nil, false, fmt.Errorf("choose game category: %w", err)
+	}
+
+	switch {
+	case chosenIndex == 0:
+		category, err = application.ChoiceCategory(categories, random)
 		if err != nil {
-			return nil, domain.UnknownDifficulty, -1, fmt.Errorf("get absolute path: %w", err)
+			return nil, false, fmt.Errorf("random choose category: %w", err)
 		}
+
+		slog.Info("Random chosen category", slog.String("category", category.Name))
+
+		return category, false, nil
+	case chosenIndex > len(categories):
+		slog.Info("Setter's word is chosen")
+
+		return nil, true, nil
 	}
 
-	slog.Info("Flags parsed",
-		slog.String("path", jsonAbsPath),
-		slog.String("difficulty", difficulty.String()),
-		slog.Int("maxMistakes", maxMistakes))
+	slog.Info("Chosen category", slog.String("category", categories[chosenIndex-1].Name))
+
+	return &categories[chosenIndex-1], false, nil
+}
+
+func ChooseMatchDifficulty(levels domain.DifficultyLevels, menu climenu.MenuProvider) (difficulty domain.Difficulty, err error) {
+	menu.AddItem("Random difficulty every round")
+	addDifficultyItems(levels, menu)
+	menu.AddItem("Adaptive difficulty (goes up and down with your results)")
 
-	if maxMistakes < 1 || maxMistakes > ('Z'-'A'+1) {
-		slog.Warn("Invalid maxMistakes value, set default value", slog.Int("maxMistakes", maxMistakes))
-		maxMistakes = domain.StateCount
+	slog.Info("Start choose match difficulty menu", slog.Any("menu", menu))
+
+	chosenIndex, err := menu.RunMenu()
+	if err != nil {
+		return domain.UnknownDifficulty, fmt.Errorf("choose match difficulty: %w", err)
+	}
+
+	switch {
+	case chosenIndex == 0:
+		slog.Info("Match difficulty will be chosen randomly every round")
+
+		return domain.UnknownDifficulty, nil
+	case chosenIndex > levels.Count():
+		slog.Info("Match difficulty will follow the results")
+
+		return domain.AdaptiveDifficulty, nil
 	}
 
-	wordsCollection, err := ReadCollectionFromFile(jsonAbsPath, schemaPath)
+	slog.Info("Chosen match difficulty", slog.String("difficulty", levels.Name(domain.Difficulty(chosenIndex-1))))
+
+	return domain.Difficulty(chosenIndex - 1), nil
+}
+
+func ChooseMatchCategory(categories []domain.Category, menu climenu.MenuProvider) (category *domain.Category, err error) {
+	menu.AddItem("Random category every round")
+	addCategoryItems(categories, menu)
+
+	slog.Info("Start choose match category menu", slog.Any("menu", menu))
+
+	chosenIndex, err := menu.RunMenu()
+	if err != nil {
+		return nil, fmt.Errorf("choose match category: %w", err)
+	}
+
+	if chosenIndex == 0 {
+		slog.Info("Match category will be chosen randomly every round")
+
+		return nil, nil
+	}
+
+	slog.Info("Chosen match category", slog.String("category", categories[chosenIndex-1].Name))
+
+	return &categories[chosenIndex-1], nil
+}
+
+func Init(defaultSamplePath, schemaPath, savePath string) (settings *Settings, err error) {
+	params := InitFlagsParameters()
+	if params.Path == "" {
+		params.Path = defaultSamplePath
+	}
+
+	params.Path, err = filepath.Abs(params.Path)
+	if err != nil {
+		return nil, fmt.Errorf("get absolute path: %w", err)
+	}
+
+	slog.Info("Flags parsed", slog.Any("flags", params))
+
+	seed := params.Seed.Seed
+	if !params.Seed.IsSet {
+		seed = rand.Uint64()
+	}
+
+	// The seed is logged, so the session can be played again with -seed
+	slog.Info("Random seed", slog.Uint64("seed", seed))
+
+	random := application.NewRandom(seed)
+
+	settings, err = initSettings(params, random, schemaPath, savePath)
+	if err != nil {
+		return nil, err
+	}
+
+	settings.Random = random
+
+	return settings, nil
+}
+
+func initSettings(params *FlagsParameters, random *rand.Rand, schemaPath, savePath string) (settings *Settings, err error) {
+	switch {
+	case params.Command == ReplayCommand:
+		return initReplay(params.ReplayPath)
+	case params.Command == StatsCommand:
+		return &Settings{Command: StatsCommand, Profile: params.Profile}, nil
+	case params.Resume:
+		return initResume(savePath, params.Profile)
+	}
+
+	players, err := validateParams(params)
 	if err != nil {
-		return nil, domain.UnknownDifficulty, -1, fmt.Errorf("read collection from file: %w", err)
+		return nil, fmt.Errorf("validate flags: %w", err)
+	}
+
+	wordsCollection, err := ReadCollectionFromFile(params.Path, schemaPath)
+	if err != nil {
+		return nil, fmt.Errorf("read collection from file: %w", err)
 	} else if wordsCollection == nil || len(wordsCollection.Categories) == 0 {
-		return nil, domain.UnknownDifficulty, -1, &domain.BadWordsCollectionError{Message: "words collection is empty"}
+		return nil, &domain.BadWordsCollectionError{Message: "words collection is empty"}
 	}
 
 	slog.Info("Read words collection", slog.Any("words collection", wordsCollection))
 
-	if difficulty == domain.UnknownDifficulty {
-		difficulty, err = ChooseDifficulty(climenu.NewMenu("Choose difficulty:"))
+	if params.MaxMistakes > wordsCollection.Alphabet.Size() {
+		slog.Warn("maxMistakes exceeds the alphabet size, set default value", slog.Int("maxMistakes", params.MaxMistakes))
+		params.MaxMistakes = min(domain.StateCount, wordsCollection.Alphabet.Size())
+	}
+
+	settings = &Settings{
+		Command:        params.Command,
+		Categories:     wordsCollection.Categories,
+		Alphabet:       wordsCollection.Alphabet,
+		Difficulty:     parseDifficulty(params.Difficulty, wordsCollection.Levels),
+		Levels:         wordsCollection.Levels,
+		MaxMistakes:    params.MaxMistakes,
+		Rounds:         params.Rounds,
+		Players:        players,
+		MistakesRule:   params.MistakesRule,
+		Evil:           params.Evil && params.Rounds == 1 && players == nil,
+		SetterWord:     params.Setter && params.Rounds == 1 && players == nil && !params.Evil,
+		Practice:       params.Practice && params.Rounds == 1 && players == nil && !params.Evil,
+		Opponent:       params.Opponent && params.Practice && params.Rounds == 1 && players == nil && !params.Evil,
+		Runs:           params.Runs,
+		Format:         params.Format,
+		HintPolicy:     params.HintPolicy.Policy,
+		TimeLimits:     domain.TimeLimits{Guess: params.GuessTime, Game: params.GameTime},
+		Profile:        params.Profile,
+		CollectionPath: params.Path,
+		CollectionHash: wordsCollection.ContentHash(),
+		Random:         random,
+		Tags:           params.Tags,
+	}
+
+	switch {
+	case settings.Command != "":
+		return settings, nil
+	case settings.IsMatch():
+		return initMatch(settings)
+	default:
+		return initSingleGame(settings)
+	}
+}
+
+func validateParams(params *FlagsParameters) (players []string, err error) {
+	if !slices.Contains([]string{"", SolveCommand, SimulateCommand, DailyCommand}, params.Command) {
+		return nil, fmt.Errorf("unknown command %q", params.Command)
+	}
+
+	if params.Runs < 1 {
+		slog.Warn("Invalid runs value, set default value", slog.Int("runs", params.Runs))
+		params.Runs = application.DefaultSimulationRuns
+	}
+
+	if params.MaxMistakes < 0 {
+		slog.Warn("Invalid maxMistakes value, set default value", slog.Int("maxMistakes", params.MaxMistakes))
+		params.MaxMistakes = 0
+	}
+
+	if params.GuessTime < 0 || params.GameTime < 0 {
+		slog.Warn("Invalid time limits, the game is not timed",
+			slog.Duration("guessTime", params.GuessTime), slog.Duration("gameTime", params.GameTime))
+		params.GuessTime, params.GameTime = 0, 0
+	}
+
+	if params.Rounds < 1 || (params.Players != "" && params.Rounds != 1) {
+		slog.Warn("Invalid rounds value, set default value", slog.Int("rounds", params.Rounds))
+		params.Rounds = 1
+	}
+
+	// A single game has no next game to adapt the level to
+	if params.Difficulty == "adaptive" && params.Rounds == 1 {
+		slog.Warn("Adaptive difficulty is used only in matches, choose difficulty in the menu")
+		params.Difficulty = ""
+	}
+
+	players, err = parsePlayers(params.Players)
+	if err != nil {
+		return nil, fmt.Errorf("parse players: %w", err)
+	}
+
+	return players, nil
+}
+
+func initSingleGame(settings *Settings) (*Settings, error) {
+	var err error
+
+	if settings.SetterWord {
+		return initSetterWord(settings), nil
+	}
+
+	if settings.Difficulty == domain.UnknownDifficulty {
+		settings.Difficulty, err = ChooseDifficulty(settings.Levels, climenu.NewMenu("Choose difficulty:"), settings.Random)
 		if err != nil {
-			return nil, domain.UnknownDifficulty, -1, fmt.Errorf("start choose difficulty menu: %w", err)
+			return nil, fmt.Errorf("start choose difficulty menu: %w", err)
 		}
 	}
 
-	category, err = ChooseCategory(wordsCollection.Categories, climenu.NewMenu("Choose category:"))
+	// The setter's word is offered only in the games the -setter flag is allowed in
+	if settings.IsHotSeat() || settings.Evil {
+		settings.Category, err = ChooseCategory(settings.Categories, climenu.NewMenu("Choose category:"), settings.Random)
+	} else {
+		settings.Category, settings.SetterWord, err = ChooseGameCategory(
+			settings.Categories, climenu.NewMenu("Choose category:"), settings.Random,
+		)
+	}
+
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("choose category: %w", err)
+	case settings.SetterWord:
+		return initSetterWord(settings), nil
+	case settings.Category == nil || isEmptyCategory(settings.Category):
+		return nil, &domain.BadCategoryError{Message: "category is empty"}
+	}
+
+	settings.MaxMistakes = settings.Levels.MaxMistakes(settings.Difficulty, settings.MaxMistakes)
+
+	return settings, nil
+}
+
+// initSetterWord plays the word typed in by the setter, it has no level, so maxMistakes is not taken from the levels.
+func initSetterWord(settings *Settings) *Settings {
+	settings.SetterWord = true
+	settings.Category = &domain.Category{Name: SetterCategoryName, Alphabet: settings.Alphabet}
+	settings.Difficulty = domain.UnknownDifficulty
+	settings.MaxMistakes = settings.Levels.MaxMistakes(settings.Difficulty, settings.MaxMistakes)
+
+	return settings
+}
+
+func parsePlayers(players string) ([]string, error) {
+	if players == "" {
+		return nil, nil
+	}
+
+	names := strings.Split(players, ",")
+	for i := range names {
+		names[i] = strings.TrimSpace(names[i])
+	}
+
+	if len(names) < domain.MinPlayers || len(names) > domain.MaxPlayers {
+		return nil, &domain.BadPlayersError{Message: fmt.Sprintf("expected from %d to %d players, got %d",
+			domain.MinPlayers, domain.MaxPlayers, len(names))}
+	}
+
+	return names, nil
+}
+
+func initResume(savePath, profile string) (*Settings, error) {
+	savedGame, err := ReadSavedGameFromFile(savePath)
+	if err != nil {
+		return nil, fmt.Errorf("read saved game: %w", err)
+	}
+
+	return &Settings{
+		Alphabet:    savedGame.Game.Alphabet(),
+		Difficulty:  savedGame.Difficulty,
+		Levels:      savedGame.Levels,
+		MaxMistakes: savedGame.Game.MaxMistakes(),
+		Rounds:      1,
+		SavedGame:   savedGame,
+		Profile:     profile,
+	}, nil
+}
+
+func initReplay(path string) (*Settings, error) {
+	if path == "" {
+		return nil, errors.New("replay command needs a recorded game file")
+	}
+
+	savedGame, err := ReadSavedGameFromFile(path)
 	if err != nil {
-		return nil, domain.UnknownDifficulty, -1, fmt.Errorf("choose category: %w", err)
-	} else if category == nil || len(category.EasyWords)+len(category.MediumWords)+len(category.HardWords) == 0 {
-		return nil, domain.UnknownDifficulty, -1, &domain.BadCategoryError{Message: "category is empty"}
+		return nil, fmt.Errorf("read recorded game: %w", err)
 	}
 
-	return category, difficulty, maxMistakes, nil
+	return &Settings{
+		Command:     ReplayCommand,
+		Alphabet:    savedGame.Game.Alphabet(),
+		Difficulty:  savedGame.Difficulty,
+		Levels:      savedGame.Levels,
+		MaxMistakes: savedGame.Game.MaxMistakes(),
+		Rounds:      1,
+		SavedGame:   savedGame,
+	}, nil
+}
+
+func initMatch(settings *Settings) (*Settings, error) {
+	var err error
+
+	if settings.Difficulty == domain.UnknownDifficulty {
+		settings.Difficulty, err = ChooseMatchDifficulty(settings.Levels, climenu.NewMenu("Choose match difficulty:"))
+		if err != nil {
+			return nil, fmt.Errorf("start choose match difficulty menu: %w", err)
+		}
+	}
+
+	settings.Category, err = ChooseMatchCategory(settings.Categories, climenu.NewMenu("Choose match category:"))
+	if err != nil {
+		return nil, fmt.Errorf("choose match category: %w", err)
+	} else if settings.Category != nil && isEmptyCategory(settings.Category) {
+		return nil, &domain.BadCategoryError{Message: "category is empty"}
+	}
+
+	return settings, nil
+}
+
+func isEmptyCategory(category *domain.Category) bool {
+	return category.WordsCount() == 0
 }
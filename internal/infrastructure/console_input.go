@@ -2,42 +2,207 @@ package infrastructure
 
 import (
 	"bufio"
+	"context"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
-	"unicode"
+	"strings"
+	"time"
 
 	"makly/hangman/internal/domain"
 )
 
+// Reserved inputs that are typed instead of a guess.
+const (
+	HintRequestInput    = "?"
+	LetterPurchaseInput = "+"
+	UndoInput           = "<"
+)
+
 type ConsoleInput struct {
-	scanner *bufio.Scanner
+	scanner  *bufio.Scanner
+	alphabet *domain.Alphabet
+	// lines are scanned in the background once input is read with a context, because scanning can not be interrupted.
+	lines chan scannedLine
+	// timedOut is set when the last read ended with its context, then the lines typed late are discarded.
+	timedOut bool
 }
 
-func NewConsoleInput() *ConsoleInput {
-	return &ConsoleInput{scanner: bufio.NewScanner(os.Stdin)}
+type scannedLine struct {
+	text string
+	err  error
+	// at is when the line is typed.
+	at time.Time
 }
 
-func (c *ConsoleInput) GetLetter() (letter rune, err error) {
+func NewConsoleInput(alphabet *domain.Alphabet) *ConsoleInput {
+	if alphabet == nil {
+		alphabet = domain.LatinAlphabet
+	}
+
+	return &ConsoleInput{scanner: bufio.NewScanner(os.Stdin), alphabet: alphabet}
+}
+
+func (c *ConsoleInput) readLine() (text string, err error) {
+	if c.lines != nil {
+		return c.readLineContext(context.Background())
+	}
+
 	c.scanner.Scan()
 
 	err = c.scanner.Err()
 	if err != nil {
-		return 0, fmt.Errorf("getting letter via bufio: %w", err)
+		return "", fmt.Errorf("reading line via bufio: %w", err)
 	}
 
-	text := c.scanner.Text()
+	return c.scanner.Text(), nil
+}
+
+func (c *ConsoleInput) scanLines() {
+	for c.scanner.Scan() {
+		c.lines <- scannedLine{text: c.scanner.Text(), at: time.Now()}
+	}
+
+	err := c.scanner.Err()
+	if err == nil {
+		err = io.EOF
+	}
+
+	c.lines <- scannedLine{err: fmt.Errorf("reading line via bufio: %w", err)}
+	close(c.lines)
+}
 
-	slog.Info("Got letter from standard cin", slog.String("letter", text))
+func (c *ConsoleInput) readLineContext(ctx context.Context) (text string, err error) {
+	if c.lines == nil {
+		c.lines = make(chan scannedLine)
+		go c.scanLines()
+	}
 
-	if len([]rune(text)) != 1 {
+	// A line typed after the time is over and before this read answers the previous prompt, so it is discarded
+	var discardBefore time.Time
+	if c.timedOut {
+		discardBefore, c.timedOut = time.Now(), false
+	}
+
+	for {
+		if ctx.Err() != nil {
+			c.timedOut = true
+			return "", context.Cause(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			c.timedOut = true
+			return "", context.Cause(ctx)
+		case line, ok := <-c.lines:
+			if !ok {
+				return "", fmt.Errorf("reading line via bufio: %w", io.EOF)
+			}
+
+			if line.err == nil && line.at.Before(discardBefore) {
+				slog.Info("Line typed after the time is over is discarded")
+				continue
+			}
+
+			return line.text, line.err
+		}
+	}
+}
+
+func (c *ConsoleInput) GetLetter() (letter rune, err error) {
+	text, err := c.readLine()
+	if err != nil {
+		return 0, fmt.Errorf("getting letter: %w", err)
+	}
+
+	slog.Info("Got letter from standard cin", domain.SecretAttr("letter", text))
+
+	return parseLetter(text, c.alphabet)
+}
+
+func (c *ConsoleInput) GetGuess() (guess *domain.Guess, err error) {
+	text, err := c.readLine()
+	if err != nil {
+		return nil, fmt.Errorf("getting guess: %w", err)
+	}
+
+	return parseGuess(text, c.alphabet)
+}
+
+func (c *ConsoleInput) GetGuessContext(ctx context.Context) (guess *domain.Guess, err error) {
+	text, err := c.readLineContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting guess: %w", err)
+	}
+
+	return parseGuess(text, c.alphabet)
+}
+
+func parseGuess(text string, alphabet *domain.Alphabet) (guess *domain.Guess, err error) {
+	slog.Info("Got guess from standard cin", domain.SecretAttr("guess", text))
+
+	// Spaces around the guess and doubled spaces inside a phrase are typos, they do not make a letter a word
+	text = strings.Join(strings.Fields(text), " ")
+
+	switch text {
+	case HintRequestInput:
+		return domain.NewHintRequest(), nil
+	case LetterPurchaseInput:
+		return domain.NewLetterPurchase(), nil
+	case UndoInput:
+		return domain.NewUndoRequest(), nil
+	}
+
+	if len([]rune(text)) == 1 {
+		letter, err := parseLetter(text, alphabet)
+		if err != nil {
+			return nil, err
+		}
+
+		return domain.NewLetterGuess(letter), nil
+	}
+
+	word, err := parseWord(text, alphabet)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewWordGuess(word), nil
+}
+
+func (c *ConsoleInput) WaitContinue() (err error) {
+	if _, err := c.readLine(); err != nil {
+		return fmt.Errorf("waiting for continue: %w", err)
+	}
+
+	slog.Info("Got continue from standard cin")
+
+	return nil
+}
+
+func parseLetter(text string, alphabet *domain.Alphabet) (letter rune, err error) {
+	runes := []rune(text)
+	if len(runes) != 1 {
 		return 0, &domain.InputerError{Message: "not a single letter", InnerError: nil}
 	}
 
-	letter = rune(text[0])
-	if (letter < 'a' || letter > 'z') && (letter < 'A' || letter > 'Z') {
+	letter = alphabet.Normalize(runes[0])
+	if !alphabet.IsLetter(letter) {
 		return 0, &domain.InputerError{Message: "letter validation", InnerError: nil}
 	}
 
-	return unicode.ToLower(letter), nil
+	return letter, nil
+}
+
+func parseWord(text string, alphabet *domain.Alphabet) (word string, err error) {
+	if text == "" {
+		return "", &domain.InputerError{Message: "empty guess", InnerError: nil}
+	}
+
+	if err := alphabet.ValidateWord(text); err != nil {
+		return "", &domain.InputerError{Message: "word validation", InnerError: err}
+	}
+
+	return alphabet.FoldWord(text), nil
 }
@@ -1,10 +1,15 @@
 package infrastructure
 
 import (
+	"cmp"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
+	"time"
 	"unicode"
 
+	"makly/hangman/internal/application"
 	"makly/hangman/internal/domain"
 	"makly/hangman/internal/draw"
 )
@@ -20,10 +25,10 @@ func (c *ConsoleOutput) showState(state domain.State) {
 	fmt.Print(draw.StringStates[state])
 }
 
-func (c *ConsoleOutput) showUsed(used map[rune]bool) {
+func (c *ConsoleOutput) showUsed(letters []rune, used map[rune]bool) {
 	fmt.Print("Used: ")
 
-	for letter := 'a'; letter <= 'z'; letter++ {
+	for _, letter := range letters {
 		if used[letter] {
 			// Bold the used letters
 			fmt.Printf("\033[1m")
@@ -45,13 +50,16 @@ func (c *ConsoleOutput) showMistakes(mistakes, maxMistakes int) {
 	fmt.Printf("Mistakes: %d / %d\n", mistakes, maxMistakes)
 }
 
-func (c *ConsoleOutput) showHint(hint string) {
-	reversedHint := ""
-	for _, r := range hint {
-		reversedHint = string(r) + reversedHint
+func (c *ConsoleOutput) showHints(hints []string) {
+	for i, hint := range hints {
+		fmt.Printf("Hint %d: %s\n", i+1, hint)
 	}
+}
 
-	fmt.Printf("Reversed hint: %s\n", reversedHint)
+func (c *ConsoleOutput) showPowerUps(powerUps domain.PowerUps) {
+	if powerUps.Letters > 0 {
+		fmt.Printf("Letters to buy: %d (mistakes cost: %d)\n", powerUps.Letters, powerUps.LetterCost)
+	}
 }
 
 func (c *ConsoleOutput) showPattern(pattern string) {
@@ -63,27 +71,155 @@ func (c *ConsoleOutput) clear() {
 	fmt.Printf("\033[2J")
 }
 
-func (c *ConsoleOutput) ShowGame(game *domain.Game) {
-	c.clear()
-
+func (c *ConsoleOutput) showGameBoard(game domain.GameView) {
 	c.showAttempts(game.Attempts())
 	c.showMistakes(game.Mistakes(), game.MaxMistakes())
-	c.showUsed(game.Used())
+	c.showUsed(game.Alphabet().Letters(), game.Used())
+	c.showPowerUps(game.PowerUps())
 	c.showState(game.State())
 	fmt.Printf("\n\n")
 	c.showPattern(game.Pattern())
 
-	if game.IsHintAvailable() {
-		c.showHint(game.Hint())
+	c.showHints(game.Hints())
+
+	fmt.Printf("\n")
+}
+
+func (c *ConsoleOutput) showPlayers(hotSeat *domain.HotSeatGame) {
+	fmt.Printf("Players (%s mistakes):\n", strings.ToLower(hotSeat.Rule().String()))
+
+	current := hotSeat.CurrentPlayer()
+
+	for i := range hotSeat.Players() {
+		player := &hotSeat.Players()[i]
+
+		marker := "  "
+		if player == current && !hotSeat.IsFinished() {
+			marker = "->"
+		}
+
+		status := ""
+		if player.IsEliminated() {
+			status = " (out)"
+		}
+
+		if hotSeat.Rule() == domain.PersonalMistakes {
+			fmt.Printf("%s %s: mistakes %d / %d%s\n", marker, player.Name(), player.Mistakes(), hotSeat.PlayerMaxMistakes(), status)
+		} else {
+			fmt.Printf("%s %s: mistakes %d%s\n", marker, player.Name(), player.Mistakes(), status)
+		}
 	}
 
 	fmt.Printf("\n")
-	fmt.Printf("Guess next letter: ")
+}
+
+func (c *ConsoleOutput) ShowGame(game domain.GameView) {
+	c.clear()
+
+	c.showGameBoard(game)
+
+	fmt.Printf("Guess next letter or the whole word")
+	c.showGuessOptions(game)
+
+	if game.CanUndo() {
+		fmt.Printf(", type %s to take back the last guess", UndoInput)
+	}
+
+	fmt.Printf(": ")
 
 	slog.Info("Current game state printed", slog.Any("game", game))
 }
 
-func (c *ConsoleOutput) ShowGameResult(game *domain.Game) {
+func (c *ConsoleOutput) showGuessOptions(game domain.GameView) {
+	if cost, ok := game.HintRequestCost(); ok {
+		fmt.Printf(", type %s for a hint (mistakes cost: %d)", HintRequestInput, cost)
+	}
+
+	if game.CanBuyLetter() {
+		fmt.Printf(", type %s to buy a letter", LetterPurchaseInput)
+	}
+}
+
+// timeLeftColumn places the time left to the right of the attempts line, so it does not move the typed guess.
+const timeLeftColumn = 30
+
+func formatTimeLeft(left time.Duration) string {
+	seconds := int((left + time.Second - 1) / time.Second)
+
+	return fmt.Sprintf("%02d:%02d", seconds/60, seconds%60)
+}
+
+func (c *ConsoleOutput) ShowTimeLeft(guessLeft, gameLeft time.Duration) {
+	timeLeft := make([]string, 0, 2)
+
+	if guessLeft > 0 {
+		timeLeft = append(timeLeft, "guess "+formatTimeLeft(guessLeft))
+	}
+
+	if gameLeft > 0 {
+		timeLeft = append(timeLeft, "game "+formatTimeLeft(gameLeft))
+	}
+
+	// Save the cursor, rewrite the end of the first line and return to the typed guess
+	fmt.Printf("\0337\033[1;%dH\033[KTime left: %s\0338", timeLeftColumn, strings.Join(timeLeft, ", "))
+}
+
+func (c *ConsoleOutput) ShowReplayStep(game domain.GameView, event *domain.Event) {
+	c.clear()
+
+	c.showGameBoard(game)
+
+	fmt.Printf("%s %s\n", event.Time.Local().Format(time.TimeOnly), describeEvent(event))
+
+	slog.Info("Replay step printed", slog.Any("event", event))
+}
+
+func (c *ConsoleOutput) ShowDailyResult(result *domain.DailyResult, playedBefore bool) {
+	switch {
+	case !result.Finished:
+		fmt.Printf("The daily challenge of %s was left unfinished, come back tomorrow\n", result.Date)
+	case playedBefore:
+		fmt.Printf("You have already played the daily challenge of %s, come back tomorrow\n\n%s\n", result.Date, result.Summary)
+	default:
+		fmt.Printf("\nShare your daily result:\n\n%s\n", result.Summary)
+	}
+
+	slog.Info("Daily result printed", slog.Any("result", result))
+}
+
+func describeEvent(event *domain.Event) string {
+	result := "miss"
+	if event.Hit {
+		result = "hit"
+	}
+
+	switch event.Kind {
+	case domain.GameStarted:
+		return "Game started"
+	case domain.LetterGuessed:
+		return fmt.Sprintf("Letter %q guessed: %s", event.Letter, result)
+	case domain.WordGuessed:
+		return fmt.Sprintf("Word %q guessed: %s", event.Word, result)
+	case domain.HintRequested:
+		return "Hint requested"
+	case domain.HintShown:
+		return fmt.Sprintf("Hint shown: %s", event.Hint)
+	case domain.LetterBought:
+		return fmt.Sprintf("Letter %q bought", event.Letter)
+	case domain.GuessTimedOut:
+		return "Guess time is over"
+	case domain.GameTimedOut:
+		return "Game time is over"
+	case domain.GuessTakenBack:
+		return "Last guess taken back"
+	case domain.GameWon:
+		return "Game won"
+	default:
+		return "Game lost"
+	}
+}
+
+func (c *ConsoleOutput) ShowGameResult(game domain.GameView) {
 	if game.IsWin() {
 		fmt.Println("You won!")
 		slog.Info("Game result printed", slog.String("result", "win"))
@@ -93,6 +229,17 @@ func (c *ConsoleOutput) ShowGameResult(game *domain.Game) {
 	}
 }
 
+func (c *ConsoleOutput) ShowOpponentResult(game domain.GameView, guesses []string) {
+	result := "lost"
+	if game.IsWin() {
+		result = "won"
+	}
+
+	fmt.Printf("Solver %s with %d / %d mistakes in %d attempts: %s\n",
+		result, game.Mistakes(), game.MaxMistakes(), game.Attempts(), strings.Join(guesses, " "))
+	slog.Info("Opponent result printed", slog.String("result", result))
+}
+
 func (c *ConsoleOutput) ShowInputError(err error) {
 	// Clear the last line with last input
 	fmt.Printf("\033[1A")
@@ -100,3 +247,167 @@ func (c *ConsoleOutput) ShowInputError(err error) {
 
 	fmt.Printf("Game error: %s. Try again: ", err)
 }
+
+func (c *ConsoleOutput) ShowRoundSummary(match *domain.Match) {
+	results := match.Results()
+	last := results[len(results)-1]
+
+	fmt.Printf("\nRound %d / %d: the word was %q, round score: %d\n\n", last.Round, match.Rounds(), last.Word, last.Score)
+
+	fmt.Printf("%-6s %-20s %-10s %-10s %6s\n", "Round", "Category", "Difficulty", "Mistakes", "Score")
+
+	for _, result := range results {
+		mistakes := fmt.Sprintf("%d / %d", result.Mistakes, result.MaxMistakes)
+		difficulty := match.Levels().Title(result.Difficulty)
+		fmt.Printf("%-6d %-20s %-10s %-10s %6d\n", result.Round, result.Category, difficulty, mistakes, result.Score)
+	}
+
+	fmt.Printf("Wins: %d / %d, total score: %d\n", match.Wins(), len(results), match.TotalScore())
+
+	if adaptive := match.AdaptiveLevel(); adaptive != nil {
+		fmt.Printf("Difficulty level: %s%s\n", match.Levels().Title(adaptive.Difficulty()), formatLevelChange(adaptive.Change()))
+	}
+
+	if !match.IsFinished() {
+		fmt.Printf("\nPress Enter to start the next round: ")
+	}
+
+	slog.Info("Round summary printed", slog.Any("match", match))
+}
+
+func formatLevelChange(change int) string {
+	switch {
+	case change > 0:
+		return " (up)"
+	case change < 0:
+		return " (down)"
+	default:
+		return ""
+	}
+}
+
+func (c *ConsoleOutput) ShowHotSeatGame(hotSeat *domain.HotSeatGame) {
+	c.clear()
+
+	c.showGameBoard(hotSeat.Game())
+	c.showPlayers(hotSeat)
+
+	if !hotSeat.IsFinished() {
+		fmt.Printf("%s, guess next letter or the whole word", hotSeat.CurrentPlayer().Name())
+		c.showGuessOptions(hotSeat.Game())
+		fmt.Printf(": ")
+	}
+
+	slog.Info("Current hot seat game state printed", slog.Any("hot seat", hotSeat))
+}
+
+func (c *ConsoleOutput) ShowHotSeatResult(hotSeat *domain.HotSeatGame) {
+	winner := hotSeat.Winner()
+	if winner == nil {
+		fmt.Println("Nobody won!")
+		slog.Info("Hot seat result printed", slog.String("result", "lose"))
+
+		return
+	}
+
+	fmt.Printf("%s won!\n", winner.Name())
+	slog.Info("Hot seat result printed", slog.String("winner", winner.Name()))
+}
+
+// ShowSolveReport sums the results up by the levels, because every level has its own maxMistakes.
+func (c *ConsoleOutput) ShowSolveReport(results []application.SolveResult, levels domain.DifficultyLevels) {
+	solved := 0
+	levelSolved := make([]int, levels.Count())
+	levelWords := make([]int, levels.Count())
+	levelMaxMistakes := make([]int, levels.Count())
+
+	fmt.Printf("%-20s %-10s %-25s %-6s %-10s %s\n", "Category", "Difficulty", "Word", "Result", "Mistakes", "Guesses")
+
+	for _, result := range results {
+		status := "lost"
+		if result.Win {
+			status = "solved"
+			solved++
+			levelSolved[result.Difficulty]++
+		}
+
+		levelWords[result.Difficulty]++
+		levelMaxMistakes[result.Difficulty] = result.MaxMistakes
+
+		mistakes := fmt.Sprintf("%d / %d", result.Mistakes, result.MaxMistakes)
+		fmt.Printf("%-20s %-10s %-25s %-6s %-10s %s\n",
+			result.Category, levels.Title(result.Difficulty), result.Word, status, mistakes, strings.Join(result.Guesses, " "))
+	}
+
+	fmt.Println()
+
+	for level := range levels.Count() {
+		if levelWords[level] > 0 {
+			fmt.Printf("%-10s solved %d of %d words within %d mistakes\n",
+				levels.Title(domain.Difficulty(level)), levelSolved[level], levelWords[level], levelMaxMistakes[level])
+		}
+	}
+
+	fmt.Printf("\nSolved %d of %d words\n", solved, len(results))
+
+	slog.Info("Solve report printed", slog.Int("solved", solved), slog.Int("words", len(results)))
+}
+
+func (c *ConsoleOutput) ShowStats(profile *domain.Profile, levels domain.DifficultyLevels) {
+	stats := profile.Stats()
+
+	fmt.Printf("Profile %s\n\n", profile.Name)
+	fmt.Printf("%-20s %s\n", "Total", formatWinRate(stats.Total))
+
+	fmt.Println("\nBy category")
+
+	categories := make([]string, 0, len(stats.ByCategory))
+	for category := range stats.ByCategory {
+		categories = append(categories, category)
+	}
+
+	slices.Sort(categories)
+
+	for _, category := range categories {
+		fmt.Printf("%-20s %s\n", category, formatWinRate(stats.ByCategory[category]))
+	}
+
+	fmt.Println("\nBy difficulty")
+
+	difficulties := make([]string, 0, len(stats.ByDifficulty))
+	for difficulty := range stats.ByDifficulty {
+		difficulties = append(difficulties, difficulty)
+	}
+
+	// The given levels go in their order, levels of other collections go after them by name
+	rank := func(name string) int {
+		if difficulty := levels.Parse(name); difficulty != domain.UnknownDifficulty {
+			return int(difficulty)
+		}
+
+		return levels.Count()
+	}
+
+	slices.SortFunc(difficulties, func(a, b string) int {
+		return cmp.Or(cmp.Compare(rank(a), rank(b)), cmp.Compare(a, b))
+	})
+
+	for _, difficulty := range difficulties {
+		fmt.Printf("%-20s %s\n", difficulty, formatWinRate(stats.ByDifficulty[difficulty]))
+	}
+
+	fmt.Printf("\nCurrent streak: %d, best streak: %d\n", stats.CurrentStreak, stats.BestStreak)
+
+	missed := make([]string, 0, len(stats.MostMissed))
+	for _, letterCount := range stats.MostMissed {
+		missed = append(missed, fmt.Sprintf("%c (%d)", letterCount.Letter, letterCount.Count))
+	}
+
+	fmt.Printf("Most missed letters: %s\n", strings.Join(missed, ", "))
+
+	slog.Info("Stats printed", slog.Any("profile", profile))
+}
+
+func formatWinRate(rate domain.WinRate) string {
+	return fmt.Sprintf("%d / %d won (%.0f%%)", rate.Wins, rate.Games, rate.Percent())
+}
@@ -0,0 +1,119 @@
+package infrastructure
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/eiannone/keyboard"
+
+	"makly/hangman/internal/domain"
+	"makly/hangman/pkg/climenu"
+)
+
+type SecretReader interface {
+	ReadSecret(prompt string) (secret string, err error)
+	ShowSecretError(err error)
+}
+
+type KeyboardSecretReader struct{}
+
+func NewKeyboardSecretReader() *KeyboardSecretReader {
+	return &KeyboardSecretReader{}
+}
+
+func (k *KeyboardSecretReader) ReadSecret(prompt string) (secret string, err error) {
+	if err := keyboard.Open(); err != nil {
+		return "", fmt.Errorf("keyboard open: %w", err)
+	}
+
+	defer func() {
+		if closeErr := keyboard.Close(); closeErr != nil {
+			if err != nil {
+				err = errors.Join(err, closeErr)
+				return
+			}
+
+			err = fmt.Errorf("keyboard close: %w", closeErr)
+		}
+	}()
+
+	fmt.Print(prompt)
+
+	runes := make([]rune, 0)
+
+	for {
+		char, key, err := keyboard.GetKey()
+		if err != nil {
+			return "", fmt.Errorf("keyboard get key: %w", err)
+		}
+
+		switch key { //nolint
+		case keyboard.KeyEnter:
+			fmt.Println()
+			return string(runes), nil
+		case keyboard.KeyEsc, keyboard.KeyCtrlC:
+			fmt.Println()
+			return "", &climenu.ExitError{}
+		case keyboard.KeyBackspace, keyboard.KeyBackspace2:
+			if len(runes) > 0 {
+				runes = runes[:len(runes)-1]
+				// Erase the last mask character
+				fmt.Print("\b \b")
+			}
+		case keyboard.KeySpace:
+			runes = append(runes, ' ')
+			fmt.Print("*")
+		default:
+			if char != 0 {
+				runes = append(runes, char)
+				fmt.Print("*")
+			}
+		}
+	}
+}
+
+func (k *KeyboardSecretReader) ShowSecretError(err error) {
+	fmt.Printf("Error: %s. Try again\n", err)
+}
+
+type SecretWordRandomizer struct {
+	reader SecretReader
+}
+
+func NewSecretWordRandomizer(reader SecretReader) *SecretWordRandomizer {
+	return &SecretWordRandomizer{reader: reader}
+}
+
+func (s *SecretWordRandomizer) ChoiceWord(category *domain.Category, _ domain.Difficulty) (word *domain.Word, err error) {
+	var alphabet *domain.Alphabet
+	if category != nil {
+		alphabet = category.Alphabet
+	}
+
+	for {
+		secret, err := s.reader.ReadSecret("Setter, type the secret word (input is hidden): ")
+		if err != nil {
+			return nil, fmt.Errorf("read secret word: %w", err)
+		}
+
+		word = &domain.Word{Word: secret}
+		if err := word.Validate(alphabet); err != nil {
+			slog.Warn("Invalid secret word", slog.Any("error", err))
+			s.reader.ShowSecretError(err)
+
+			continue
+		}
+
+		break
+	}
+
+	word.Hint, err = s.reader.ReadSecret("Setter, type an optional hint (input is hidden, press Enter to skip): ")
+	if err != nil {
+		return nil, fmt.Errorf("read secret hint: %w", err)
+	}
+
+	slog.Info("Secret word entered", slog.Any("word", word))
+
+	return word, nil
+}
@@ -79,7 +79,10 @@ func ReadCollection(jsonReader, schemaReader Reader, validator JSONBytesValidato
 
 	slog.Info("Unmarshal json")
 
-	wordsCollection = wordsCollectionJSON.ToDomain()
+	wordsCollection, err = wordsCollectionJSON.ToDomain()
+	if err != nil {
+		return nil, fmt.Errorf("convert json to domain: %w", err)
+	}
 
 	slog.Info("Convert json to domain", slog.Any("words collection", wordsCollection))
 
@@ -0,0 +1,168 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"makly/hangman/internal/domain"
+)
+
+// recordTimeLayout names the recorded games by the time they are finished.
+const recordTimeLayout = "20060102-150405.000"
+
+type FileGameSaver struct {
+	path string
+	// recordsDir is where finished games are recorded, they are not recorded when it is empty.
+	recordsDir string
+	// profile gets the results of finished games when it is not nil.
+	profile *ProfileStore
+}
+
+func NewFileGameSaver(path, recordsDir string, profile *ProfileStore) *FileGameSaver {
+	return &FileGameSaver{path: path, recordsDir: recordsDir, profile: profile}
+}
+
+func (f *FileGameSaver) SaveGame(savedGame *domain.SavedGame) (err error) {
+	if err := writeJSONFile(f.path, savedGame.ToJSON()); err != nil {
+		return fmt.Errorf("write saved game: %w", err)
+	}
+
+	slog.Info("Game saved", slog.String("path", f.path), slog.Any("saved game", savedGame))
+
+	return nil
+}
+
+func (f *FileGameSaver) RemoveSavedGame() (err error) {
+	if err := os.Remove(f.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("remove saved game: %w", err)
+	}
+
+	slog.Info("Saved game removed", slog.String("path", f.path))
+
+	return nil
+}
+
+// RecordGame writes the game to the records directory and adds its result to the profile.
+func (f *FileGameSaver) RecordGame(savedGame *domain.SavedGame) (err error) {
+	if f.profile != nil {
+		if profileErr := f.profile.AddGame(domain.NewGameResult(savedGame)); profileErr != nil {
+			err = fmt.Errorf("add game to profile: %w", profileErr)
+		}
+	}
+
+	if f.recordsDir == "" {
+		return err
+	}
+
+	return errors.Join(err, f.writeRecord(savedGame))
+}
+
+// RecordResult adds the result to the profile of the player, the profiles of other players are kept next to it.
+func (f *FileGameSaver) RecordResult(player string, result *domain.GameResult) (err error) {
+	if f.profile == nil {
+		return nil
+	}
+
+	profile := f.profile
+
+	if player != "" {
+		profile, err = f.profile.Other(player)
+		if err != nil {
+			return fmt.Errorf("player profile: %w", err)
+		}
+	}
+
+	if err := profile.AddGame(result); err != nil {
+		return fmt.Errorf("add game to profile: %w", err)
+	}
+
+	return nil
+}
+
+func (f *FileGameSaver) writeRecord(savedGame *domain.SavedGame) (err error) {
+	path := filepath.Join(f.recordsDir, "game-"+time.Now().Format(recordTimeLayout)+".json")
+
+	if err := writeJSONFile(path, savedGame.ToJSON()); err != nil {
+		return fmt.Errorf("record game: %w", err)
+	}
+
+	slog.Info("Game recorded", slog.String("path", path), slog.Any("saved game", savedGame))
+
+	return nil
+}
+
+func writeJSONFile(path string, value any) (err error) {
+	jsonBytes, err := json.MarshalIndent(value, "", "    ")
+	if err != nil {
+		return fmt.Errorf("marshal json: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create directory: %w", err)
+	}
+
+	// Write to a temporary file first so that an interrupted write never corrupts the previous file
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, jsonBytes, 0o600); err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("rename file: %w", err)
+	}
+
+	return nil
+}
+
+func ReadSavedGame(reader Reader) (savedGame *domain.SavedGame, err error) {
+	jsonBytes, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("read saved game: %w", err)
+	}
+
+	var savedGameJSON domain.SavedGameJSON
+
+	if err := json.Unmarshal(jsonBytes, &savedGameJSON); err != nil {
+		return nil, fmt.Errorf("unmarshal saved game: %w", err)
+	}
+
+	savedGame, err = savedGameJSON.ToDomain()
+	if err != nil {
+		return nil, fmt.Errorf("convert saved game to domain: %w", err)
+	}
+
+	slog.Info("Read saved game", slog.Any("saved game", savedGame))
+
+	return savedGame, nil
+}
+
+func ReadSavedGameFromFile(path string) (savedGame *domain.SavedGame, err error) {
+	slog.Info("Open saved game file", slog.String("path", path))
+
+	savedGameFile, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open saved game file: %w", err)
+	}
+
+	defer func() {
+		if closeErr := savedGameFile.Close(); closeErr != nil {
+			if err != nil {
+				err = errors.Join(err, closeErr)
+				return
+			}
+
+			err = fmt.Errorf("close saved game file: %w", closeErr)
+		}
+
+		slog.Info("Close saved game file", slog.String("path", path))
+	}()
+
+	return ReadSavedGame(savedGameFile)
+}
@@ -7,14 +7,18 @@ import (
 	"io"
 	"log"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"makly/hangman/internal/application"
 	"makly/hangman/internal/domain"
 	"makly/hangman/internal/infrastructure"
 	"makly/hangman/internal/infrastructure/mocks"
+	"makly/hangman/pkg/climenu"
 	menuMocks "makly/hangman/pkg/climenu/mocks"
 )
 
@@ -283,23 +287,157 @@ func TestReadCollection(t *testing.T) {
 			expectedWordsCollection: domain.WordsCollection{
 				Creator:     "John Doe",
 				Description: "Sample description",
+				Alphabet:    domain.LatinAlphabet,
+				Levels:      domain.DefaultDifficultyLevels,
 				Categories: []domain.Category{
 					{
-						Name: "Category1",
-						EasyWords: []domain.Word{
-							{Word: "apple", Hint: "A fruit"},
+						Name:     "Category1",
+						Alphabet: domain.LatinAlphabet,
+						WordsByLevel: [][]domain.Word{
+							{{Word: "apple", Hint: "A fruit"}},
+							{{Word: "banana", Hint: "Another fruit"}},
+							{{Word: "cherry", Hint: "Yet another fruit"}},
 						},
-						MediumWords: []domain.Word{
-							{Word: "banana", Hint: "Another fruit"},
+					},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "flat words list",
+			jsonBytes: []byte(`{
+                "creator": "John Doe",
+                "description": "Sample description",
+                "categories": [
+                    {
+                        "name": "Category1",
+                        "words": [
+                            {"word": "passion fruit", "hint": "A tropical fruit"},
+                            {"word": "fig", "hint": "A fruit"},
+                            {"word": "banana", "hint": "Another fruit"}
+                        ]
+                    }
+                ]
+            }`),
+			expectedWordsCollection: domain.WordsCollection{
+				Creator:     "John Doe",
+				Description: "Sample description",
+				Alphabet:    domain.LatinAlphabet,
+				Levels:      domain.DefaultDifficultyLevels,
+				Categories: []domain.Category{
+					{
+						Name:     "Category1",
+						Alphabet: domain.LatinAlphabet,
+						WordsByLevel: [][]domain.Word{
+							{{Word: "fig", Hint: "A fruit"}},
+							{{Word: "banana", Hint: "Another fruit"}},
+							{{Word: "passion fruit", Hint: "A tropical fruit"}},
 						},
-						HardWords: []domain.Word{
-							{Word: "cherry", Hint: "Yet another fruit"},
+					},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "phrases with revealed characters",
+			jsonBytes: []byte(`{
+                "creator": "John Doe",
+                "description": "Sample description",
+                "categories": [
+                    {
+                        "name": "Category1",
+                        "easy": [{"word": "o'clock", "hint": "Time"}],
+                        "medium": [{"word": "tic-tac-toe", "hint": "A game"}],
+                        "hard": [{"word": "Rock 'n' Roll", "hint": "Music"}]
+                    }
+                ]
+            }`),
+			expectedWordsCollection: domain.WordsCollection{
+				Creator:     "John Doe",
+				Description: "Sample description",
+				Alphabet:    domain.LatinAlphabet,
+				Levels:      domain.DefaultDifficultyLevels,
+				Categories: []domain.Category{
+					{
+						Name:     "Category1",
+						Alphabet: domain.LatinAlphabet,
+						WordsByLevel: [][]domain.Word{
+							{{Word: "o'clock", Hint: "Time"}},
+							{{Word: "tic-tac-toe", Hint: "A game"}},
+							{{Word: "Rock 'n' Roll", Hint: "Music"}},
 						},
 					},
 				},
 			},
 			expectedErr: nil,
 		},
+		{
+			name: "word metadata",
+			jsonBytes: []byte(`{
+                "creator": "John Doe",
+                "description": "Sample description",
+                "categories": [
+                    {
+                        "name": "Category1",
+                        "easy": [{"word": "cat", "weight": 2.5, "tags": ["Mammal"], "source": "Zoo", "addedOn": "2026-10-18"}],
+                        "medium": [{"word": "banana", "hint": "Another fruit"}],
+                        "hard": [{"word": "cherry", "hint": "Yet another fruit"}]
+                    }
+                ]
+            }`),
+			expectedWordsCollection: domain.WordsCollection{
+				Creator:     "John Doe",
+				Description: "Sample description",
+				Alphabet:    domain.LatinAlphabet,
+				Levels:      domain.DefaultDifficultyLevels,
+				Categories: []domain.Category{
+					{
+						Name:     "Category1",
+						Alphabet: domain.LatinAlphabet,
+						WordsByLevel: [][]domain.Word{
+							{{Word: "cat", Weight: 2.5, Tags: []string{"mammal"}, Source: "Zoo", AddedOn: "2026-10-18"}},
+							{{Word: "banana", Hint: "Another fruit"}},
+							{{Word: "cherry", Hint: "Yet another fruit"}},
+						},
+					},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "bad added on date",
+			jsonBytes: []byte(`{
+                "creator": "John Doe",
+                "description": "Sample description",
+                "categories": [
+                    {
+                        "name": "Category1",
+                        "easy": [{"word": "cat", "addedOn": "18.10.2026"}],
+                        "medium": [{"word": "banana", "hint": "Another fruit"}],
+                        "hard": [{"word": "cherry", "hint": "Yet another fruit"}]
+                    }
+                ]
+            }`),
+			expectedWordsCollection: domain.WordsCollection{},
+			expectedErr:             &domain.BadWordError{},
+		},
+		{
+			name: "hidden mark in a word",
+			jsonBytes: []byte(`{
+                "creator": "John Doe",
+                "description": "Sample description",
+                "categories": [
+                    {
+                        "name": "Category1",
+                        "easy": [{"word": "snake_case", "hint": "A style"}],
+                        "medium": [{"word": "banana", "hint": "Another fruit"}],
+                        "hard": [{"word": "cherry", "hint": "Yet another fruit"}]
+                    }
+                ]
+            }`),
+			expectedWordsCollection: domain.WordsCollection{},
+			expectedErr:             &domain.BadWordError{},
+		},
 		{
 			name: "invalid JSON - type mismatch",
 			jsonBytes: []byte(`{
@@ -371,50 +509,117 @@ func TestInitFlagsParameters(t *testing.T) {
 		name                string
 		args                []string
 		expectedPath        string
-		expectedDifficulty  domain.Difficulty
+		expectedDifficulty  string
 		expectedMaxMistakes int
+		expectedResume      bool
+		expectedRounds      int
+		expectedPlayers     string
+		expectedRule        domain.MistakesRule
+		expectedEvil        bool
+		expectedSetter      bool
+		expectedCommand     string
 	}{
 		{
 			name:                "default values",
 			args:                []string{},
 			expectedPath:        "",
-			expectedDifficulty:  domain.UnknownDifficulty,
-			expectedMaxMistakes: domain.StateCount,
+			expectedDifficulty:  "",
+			expectedMaxMistakes: 0,
+			expectedRounds:      1,
 		},
 		{
 			name:                "valid arguments",
 			args:                []string{"-path", "test/path", "-difficulty", "medium", "-maxmistakes", "5"},
 			expectedPath:        "test/path",
-			expectedDifficulty:  domain.MediumDifficulty,
+			expectedDifficulty:  "medium",
 			expectedMaxMistakes: 5,
+			expectedRounds:      1,
 		},
 		{
 			name:                "invalid difficulty",
 			args:                []string{"-path", "test/path", "-difficulty", "invalid", "-maxmistakes", "5"},
 			expectedPath:        "test/path",
-			expectedDifficulty:  domain.UnknownDifficulty,
+			expectedDifficulty:  "invalid",
 			expectedMaxMistakes: 5,
+			expectedRounds:      1,
 		},
 		{
 			name:                "missing max mistakes",
 			args:                []string{"-path", "test/path", "-difficulty", "medium"},
 			expectedPath:        "test/path",
-			expectedDifficulty:  domain.MediumDifficulty,
-			expectedMaxMistakes: domain.StateCount,
+			expectedDifficulty:  "medium",
+			expectedMaxMistakes: 0,
+			expectedRounds:      1,
 		},
 		{
 			name:                "no args",
 			args:                []string{},
 			expectedPath:        "",
-			expectedDifficulty:  domain.UnknownDifficulty,
-			expectedMaxMistakes: domain.StateCount,
+			expectedDifficulty:  "",
+			expectedMaxMistakes: 0,
+			expectedRounds:      1,
+		},
+		{
+			name:                "resume",
+			args:                []string{"-resume"},
+			expectedPath:        "",
+			expectedDifficulty:  "",
+			expectedMaxMistakes: 0,
+			expectedRounds:      1,
+			expectedResume:      true,
+		},
+		{
+			name:                "match rounds",
+			args:                []string{"-rounds", "5", "-difficulty", "hard"},
+			expectedPath:        "",
+			expectedDifficulty:  "hard",
+			expectedMaxMistakes: 0,
+			expectedRounds:      5,
+		},
+		{
+			name:                "hot seat",
+			args:                []string{"-players", "Alice,Bob", "-mistakesrule", "personal"},
+			expectedPath:        "",
+			expectedDifficulty:  "",
+			expectedMaxMistakes: 0,
+			expectedRounds:      1,
+			expectedPlayers:     "Alice,Bob",
+			expectedRule:        domain.PersonalMistakes,
+		},
+		{
+			name:                "evil",
+			args:                []string{"-evil"},
+			expectedPath:        "",
+			expectedDifficulty:  "",
+			expectedMaxMistakes: 0,
+			expectedRounds:      1,
+			expectedEvil:        true,
+		},
+		{
+			name:                "setter",
+			args:                []string{"-setter"},
+			expectedPath:        "",
+			expectedDifficulty:  "",
+			expectedMaxMistakes: 0,
+			expectedRounds:      1,
+			expectedSetter:      true,
+		},
+		{
+			name:                "solve command",
+			args:                []string{"solve", "-maxmistakes", "3"},
+			expectedPath:        "",
+			expectedDifficulty:  "",
+			expectedMaxMistakes: 3,
+			expectedRounds:      1,
+			expectedCommand:     "solve",
 		},
 		{
 			name:                "only path",
 			args:                []string{"-path", "test/path"},
 			expectedPath:        "test/path",
-			expectedDifficulty:  domain.UnknownDifficulty,
-			expectedMaxMistakes: domain.StateCount,
+			expectedDifficulty:  "",
+			expectedMaxMistakes: 0,
+			expectedRounds:      1,
 		},
 	}
 
@@ -424,14 +629,153 @@ func TestInitFlagsParameters(t *testing.T) {
 
 		os.Args = append([]string{"cmd"}, tt.args...)
 
-		gotPath, gotDifficulty, gotMaxMistakes := infrastructure.InitFlagsParameters()
+		params := infrastructure.InitFlagsParameters()
 
-		assert.Equal(t, tt.expectedPath, gotPath)
-		assert.Equal(t, tt.expectedDifficulty, gotDifficulty)
-		assert.Equal(t, tt.expectedMaxMistakes, gotMaxMistakes)
+		assert.Equal(t, tt.expectedPath, params.Path)
+		assert.Equal(t, tt.expectedDifficulty, params.Difficulty)
+		assert.Equal(t, tt.expectedMaxMistakes, params.MaxMistakes)
+		assert.Equal(t, tt.expectedResume, params.Resume)
+		assert.Equal(t, tt.expectedRounds, params.Rounds)
+		assert.Equal(t, tt.expectedPlayers, params.Players)
+		assert.Equal(t, tt.expectedRule, params.MistakesRule)
+		assert.Equal(t, tt.expectedEvil, params.Evil)
+		assert.Equal(t, tt.expectedSetter, params.Setter)
+		assert.Equal(t, tt.expectedCommand, params.Command)
 	}
 }
 
+func TestInitHintsFlag(t *testing.T) {
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	os.Args = []string{"cmd", "-hints", "request:2"}
+
+	params := infrastructure.InitFlagsParameters()
+	assert.Equal(t, domain.OnRequestHints{Cost: 2}, params.HintPolicy.Policy)
+
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	os.Args = []string{"cmd"}
+
+	params = infrastructure.InitFlagsParameters()
+	assert.Nil(t, params.HintPolicy.Policy)
+}
+
+func TestInitOpponentFlag(t *testing.T) {
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	os.Args = []string{"cmd", "-practice", "-opponent"}
+
+	params := infrastructure.InitFlagsParameters()
+	assert.True(t, params.Practice)
+	assert.True(t, params.Opponent)
+}
+
+func TestInitReplayCommand(t *testing.T) {
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	os.Args = []string{"cmd", "replay", "records/game.json"}
+
+	params := infrastructure.InitFlagsParameters()
+	assert.Equal(t, infrastructure.ReplayCommand, params.Command)
+	assert.Equal(t, "records/game.json", params.ReplayPath)
+}
+
+func TestInitStatsCommand(t *testing.T) {
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	os.Args = []string{"cmd", "stats", "-profile", "alice"}
+
+	params := infrastructure.InitFlagsParameters()
+	assert.Equal(t, infrastructure.StatsCommand, params.Command)
+	assert.Equal(t, "alice", params.Profile)
+
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	os.Args = []string{"cmd"}
+
+	params = infrastructure.InitFlagsParameters()
+	assert.Equal(t, domain.DefaultProfileName, params.Profile)
+}
+
+func TestInitSeedFlag(t *testing.T) {
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	os.Args = []string{"cmd", "-seed", "42"}
+
+	params := infrastructure.InitFlagsParameters()
+	assert.Equal(t, infrastructure.SeedFlag{Seed: 42, IsSet: true}, params.Seed)
+
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	os.Args = []string{"cmd"}
+
+	params = infrastructure.InitFlagsParameters()
+	assert.False(t, params.Seed.IsSet)
+	assert.Error(t, params.Seed.Set("-1"))
+}
+
+func TestInitTagsFlag(t *testing.T) {
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	os.Args = []string{"cmd", "-tags", "animals+!obscure"}
+
+	params := infrastructure.InitFlagsParameters()
+	assert.Equal(t, domain.TagFilter{Required: []string{"animals"}, Excluded: []string{"obscure"}}, params.Tags)
+}
+
+func TestInitTimeFlags(t *testing.T) {
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	os.Args = []string{"cmd", "-guesstime", "30s", "-gametime", "5m"}
+
+	params := infrastructure.InitFlagsParameters()
+	assert.Equal(t, 30*time.Second, params.GuessTime)
+	assert.Equal(t, 5*time.Minute, params.GameTime)
+}
+
+func TestParseHintPolicies(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	levels := domain.DefaultDifficultyLevels
+
+	policies, err := infrastructure.ParseHintPolicies("never", map[string]string{"easy": "always", "Hard": "request:2"}, levels)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.AlwaysHints{}, policies.For(domain.EasyDifficulty))
+	assert.Equal(t, domain.NeverHints{}, policies.For(domain.MediumDifficulty))
+	assert.Equal(t, domain.OnRequestHints{Cost: 2}, policies.For(domain.HardDifficulty))
+	assert.Equal(t, domain.NeverHints{}, policies.For(domain.UnknownDifficulty))
+
+	policies, err = infrastructure.ParseHintPolicies("", nil, levels)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.DefaultHintPolicy, policies.For(domain.EasyDifficulty))
+
+	_, err = infrastructure.ParseHintPolicies("sometimes", nil, levels)
+	assert.Error(t, err)
+
+	_, err = infrastructure.ParseHintPolicies("", map[string]string{"extreme": "never"}, levels)
+	assert.Error(t, err)
+
+	// The policy of a level is used when the config has none for it
+	levels = domain.DifficultyLevels{{Name: "beginner", HintPolicy: domain.AlwaysHints{}}, {Name: "expert"}}
+
+	policies, err = infrastructure.ParseHintPolicies("never", map[string]string{"expert": "request:1"}, levels)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.AlwaysHints{}, policies.For(0))
+	assert.Equal(t, domain.OnRequestHints{Cost: 1}, policies.For(1))
+
+	_, err = infrastructure.ParseHintPolicies("", map[string]string{"easy": "never"}, levels)
+	assert.Error(t, err)
+}
+
+func TestInitSimulateFlags(t *testing.T) {
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	os.Args = []string{"cmd", "simulate", "-runs", "20", "-format", "csv"}
+
+	params := infrastructure.InitFlagsParameters()
+
+	assert.Equal(t, infrastructure.SimulateCommand, params.Command)
+	assert.Equal(t, 20, params.Runs)
+	assert.Equal(t, infrastructure.CSVReport, params.Format)
+
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	os.Args = []string{"cmd", "simulate"}
+
+	params = infrastructure.InitFlagsParameters()
+
+	assert.Equal(t, application.DefaultSimulationRuns, params.Runs)
+	assert.Equal(t, infrastructure.JSONReport, params.Format)
+}
+
 func TestChooseDifficulty(t *testing.T) {
 	log.SetOutput(io.Discard)
 
@@ -439,23 +783,25 @@ func TestChooseDifficulty(t *testing.T) {
 	mockMenu := &menuMocks.MenuProvider{}
 	mockMenu.On("AddItem", mock.Anything).Return()
 
+	random := application.NewRandom(1)
+
 	mockMenu.On("RunMenu").Return(1, nil).Once()
-	difficulty, err := infrastructure.ChooseDifficulty(mockMenu)
+	difficulty, err := infrastructure.ChooseDifficulty(domain.DefaultDifficultyLevels, mockMenu, random)
 	assertInstance.NoError(err)
 	assertInstance.Equal(domain.EasyDifficulty, difficulty)
 
 	mockMenu.On("RunMenu").Return(2, nil).Once()
-	difficulty, err = infrastructure.ChooseDifficulty(mockMenu)
+	difficulty, err = infrastructure.ChooseDifficulty(domain.DefaultDifficultyLevels, mockMenu, random)
 	assertInstance.NoError(err)
 	assertInstance.Equal(domain.MediumDifficulty, difficulty)
 
 	mockMenu.On("RunMenu").Return(3, nil).Once()
-	difficulty, err = infrastructure.ChooseDifficulty(mockMenu)
+	difficulty, err = infrastructure.ChooseDifficulty(domain.DefaultDifficultyLevels, mockMenu, random)
 	assertInstance.NoError(err)
 	assertInstance.Equal(domain.HardDifficulty, difficulty)
 
 	mockMenu.On("RunMenu").Return(0, nil).Once()
-	difficulty, err = infrastructure.ChooseDifficulty(mockMenu)
+	difficulty, err = infrastructure.ChooseDifficulty(domain.DefaultDifficultyLevels, mockMenu, random)
 	assertInstance.NoError(err)
 	assertInstance.Contains([]domain.Difficulty{domain.EasyDifficulty, domain.MediumDifficulty, domain.HardDifficulty}, difficulty)
 }
@@ -479,23 +825,412 @@ func TestChooseCategory(t *testing.T) {
 	mockMenu := &menuMocks.MenuProvider{}
 	mockMenu.On("AddItem", mock.Anything).Return()
 
+	random := application.NewRandom(1)
+
 	mockMenu.On("RunMenu").Return(1, nil).Once()
-	category, err := infrastructure.ChooseCategory(categories, mockMenu)
+	category, err := infrastructure.ChooseCategory(categories, mockMenu, random)
 	assertInstance.NoError(err)
 	assertInstance.Equal("Category1", category.Name)
 
 	mockMenu.On("RunMenu").Return(2, nil).Once()
-	category, err = infrastructure.ChooseCategory(categories, mockMenu)
+	category, err = infrastructure.ChooseCategory(categories, mockMenu, random)
 	assertInstance.NoError(err)
 	assertInstance.Equal("Category2", category.Name)
 
 	mockMenu.On("RunMenu").Return(3, nil).Once()
-	category, err = infrastructure.ChooseCategory(categories, mockMenu)
+	category, err = infrastructure.ChooseCategory(categories, mockMenu, random)
 	assertInstance.NoError(err)
 	assertInstance.Equal("Category3", category.Name)
 
 	mockMenu.On("RunMenu").Return(0, nil).Once()
-	category, err = infrastructure.ChooseCategory(categories, mockMenu)
+	category, err = infrastructure.ChooseCategory(categories, mockMenu, random)
 	assertInstance.NoError(err)
 	assertInstance.Contains([]string{"Category1", "Category2", "Category3"}, category.Name)
+
+	// The secret category is chosen by the seed
+	expected, err := application.ChoiceCategory(categories, application.NewRandom(7))
+	assertInstance.NoError(err)
+
+	mockMenu.On("RunMenu").Return(0, nil).Once()
+	category, err = infrastructure.ChooseCategory(categories, mockMenu, application.NewRandom(7))
+	assertInstance.NoError(err)
+	assertInstance.Equal(expected, category)
+}
+
+func TestReadSavedGame(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	tests := []struct {
+		name               string
+		jsonBytes          []byte
+		expectedCategory   string
+		expectedDifficulty domain.Difficulty
+		expectedPattern    string
+		expectError        bool
+	}{
+		{
+			name: "valid saved game",
+			jsonBytes: []byte(`{
+                "version": 1,
+                "category": "Fruits",
+                "difficulty": "medium",
+                "maxMistakes": 6,
+                "wordGuessPenalty": 2,
+                "word": {"word": "banana", "hint": "A yellow fruit"},
+                "attempts": 2,
+                "mistakes": 1,
+                "used": ["a", "z"],
+                "usedWords": []
+            }`),
+			expectedCategory:   "Fruits",
+			expectedDifficulty: domain.MediumDifficulty,
+			expectedPattern:    "_a_a_a",
+			expectError:        false,
+		},
+		{
+			name: "setter word without difficulty",
+			jsonBytes: []byte(`{
+                "version": 1,
+                "category": "Setter's word",
+                "difficulty": "unknown",
+                "maxMistakes": 6,
+                "word": {"word": "secret", "hint": ""},
+                "used": ["e"]
+            }`),
+			expectedCategory:   "Setter's word",
+			expectedDifficulty: domain.UnknownDifficulty,
+			expectedPattern:    "_e__e_",
+			expectError:        false,
+		},
+		{
+			name: "unsupported version",
+			jsonBytes: []byte(`{
+                "version": 100,
+                "category": "Fruits",
+                "difficulty": "medium",
+                "maxMistakes": 6,
+                "word": {"word": "banana", "hint": "A yellow fruit"}
+            }`),
+			expectError: true,
+		},
+		{
+			name: "unknown difficulty",
+			jsonBytes: []byte(`{
+                "version": 1,
+                "category": "Fruits",
+                "difficulty": "impossible",
+                "maxMistakes": 6,
+                "word": {"word": "banana", "hint": "A yellow fruit"}
+            }`),
+			expectError: true,
+		},
+		{
+			name: "mistakes exceed max mistakes",
+			jsonBytes: []byte(`{
+                "version": 1,
+                "category": "Fruits",
+                "difficulty": "easy",
+                "maxMistakes": 6,
+                "word": {"word": "banana", "hint": "A yellow fruit"},
+                "mistakes": 7
+            }`),
+			expectError: true,
+		},
+		{
+			name:        "syntax error",
+			jsonBytes:   []byte(`{"version": 1`),
+			expectError: true,
+		},
+	}
+
+	assertInstance := assert.New(t)
+
+	for _, tt := range tests {
+		savedGame, err := infrastructure.ReadSavedGame(bytes.NewReader(tt.jsonBytes))
+
+		if tt.expectError {
+			assertInstance.Error(err, tt.name)
+		} else {
+			assertInstance.NoError(err, tt.name)
+			assertInstance.Equal(tt.expectedCategory, savedGame.Category, tt.name)
+			assertInstance.Equal(tt.expectedDifficulty, savedGame.Difficulty, tt.name)
+			assertInstance.Equal(tt.expectedPattern, savedGame.Game.Pattern(), tt.name)
+		}
+	}
+}
+
+func TestFileGameSaver(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	path := filepath.Join(t.TempDir(), "saves", "game.json")
+	recordsDir := filepath.Join(t.TempDir(), "records")
+	profile := infrastructure.NewProfileStoreAt("tester", t.TempDir())
+	saver := infrastructure.NewFileGameSaver(path, recordsDir, profile)
+
+	game := domain.NewGame(&domain.Word{Word: "hello world", Hint: "A greeting"}, domain.LatinAlphabet, 6, domain.DefaultWordGuessPenalty)
+	game.MakeGuess(domain.NewLetterGuess('o'))
+	game.MakeGuess(domain.NewLetterGuess('x'))
+	game.MakeGuess(domain.NewWordGuess("hello there"))
+
+	savedGame := &domain.SavedGame{Category: "Greetings", Difficulty: domain.HardDifficulty, Game: game}
+
+	assert.NoError(t, saver.SaveGame(savedGame))
+
+	loaded, err := infrastructure.ReadSavedGameFromFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, savedGame.ToJSON(), loaded.ToJSON())
+	assert.Equal(t, game.Pattern(), loaded.Game.Pattern())
+
+	assert.NoError(t, saver.RemoveSavedGame())
+
+	_, err = os.Stat(path)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	// Removing a missing save is not an error
+	assert.NoError(t, saver.RemoveSavedGame())
+
+	assert.NoError(t, saver.RecordGame(savedGame))
+
+	records, err := filepath.Glob(filepath.Join(recordsDir, "game-*.json"))
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+
+	recorded, err := infrastructure.ReadSavedGameFromFile(records[0])
+	assert.NoError(t, err)
+	assert.Equal(t, savedGame.ToJSON(), recorded.ToJSON())
+
+	playerProfile, err := profile.LoadProfile()
+	assert.NoError(t, err)
+	assert.Equal(t, "tester", playerProfile.Name)
+	assert.Equal(t, []domain.GameResult{*domain.NewGameResult(savedGame)}, playerProfile.Games)
+
+	// Results of games without a saved game go to the profile of the player, the session profile is the empty player
+	result := &domain.GameResult{Word: "cat", Won: true}
+	assert.NoError(t, saver.RecordResult("", result))
+	assert.NoError(t, saver.RecordResult("Alice", result))
+	assert.Error(t, saver.RecordResult("Alice Smith", result))
+
+	playerProfile, err = profile.LoadProfile()
+	assert.NoError(t, err)
+	assert.Len(t, playerProfile.Games, 2)
+
+	other, err := profile.Other("Alice")
+	assert.NoError(t, err)
+
+	otherProfile, err := other.LoadProfile()
+	assert.NoError(t, err)
+	assert.Equal(t, "Alice", otherProfile.Name)
+	assert.Equal(t, []domain.GameResult{*result}, otherProfile.Games)
+}
+
+func TestProfileStoreWordHistory(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	profile := infrastructure.NewProfileStoreAt("tester", t.TempDir())
+
+	history, err := profile.LoadWordHistory("animals.json")
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.WordHistory{}, history)
+
+	history = &domain.WordHistory{Recent: []string{"cat"}, Decks: map[string][]string{"Animals/easy": {"dog"}}}
+	assert.NoError(t, profile.SaveWordHistory("animals.json", history))
+	assert.NoError(t, profile.AddGame(&domain.GameResult{Word: "cat", Won: true}))
+
+	loaded, err := profile.LoadWordHistory("animals.json")
+	assert.NoError(t, err)
+	assert.Equal(t, history, loaded)
+
+	loaded, err = profile.LoadWordHistory("fruits.json")
+	assert.NoError(t, err)
+	assert.Empty(t, loaded.Recent)
+}
+
+func TestProfileStoreDailyResult(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	profile := infrastructure.NewProfileStoreAt("tester", t.TempDir())
+
+	result, err := profile.LoadDailyResult("2026-10-18", "hash")
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+
+	played := &domain.DailyResult{Date: "2026-10-18", Collection: "hash", Finished: true, Summary: "Hangman 2026-10-18 X/6"}
+	assert.NoError(t, profile.SaveDailyResult(played))
+
+	result, err = profile.LoadDailyResult("2026-10-18", "hash")
+	assert.NoError(t, err)
+	assert.Equal(t, played, result)
+}
+
+func TestChooseGameCategory(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+	categories := []domain.Category{{Name: "Category1"}, {Name: "Category2"}}
+	mockMenu := &menuMocks.MenuProvider{}
+	mockMenu.On("AddItem", mock.Anything).Return()
+
+	random := application.NewRandom(1)
+
+	mockMenu.On("RunMenu").Return(2, nil).Once()
+	category, setterWord, err := infrastructure.ChooseGameCategory(categories, mockMenu, random)
+	assertInstance.NoError(err)
+	assertInstance.False(setterWord)
+	assertInstance.Equal("Category2", category.Name)
+
+	mockMenu.On("RunMenu").Return(0, nil).Once()
+	category, setterWord, err = infrastructure.ChooseGameCategory(categories, mockMenu, random)
+	assertInstance.NoError(err)
+	assertInstance.False(setterWord)
+	assertInstance.Contains([]string{"Category1", "Category2"}, category.Name)
+
+	// The last item is the setter's word
+	mockMenu.On("RunMenu").Return(3, nil).Once()
+	category, setterWord, err = infrastructure.ChooseGameCategory(categories, mockMenu, random)
+	assertInstance.NoError(err)
+	assertInstance.True(setterWord)
+	assertInstance.Nil(category)
+	mockMenu.AssertCalled(t, "AddItem", "Setter's word (another player types the secret word, you guess it)")
+}
+
+func TestChooseMatchDifficulty(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+	mockMenu := &menuMocks.MenuProvider{}
+	mockMenu.On("AddItem", mock.Anything).Return()
+
+	mockMenu.On("RunMenu").Return(2, nil).Once()
+	difficulty, err := infrastructure.ChooseMatchDifficulty(domain.DefaultDifficultyLevels, mockMenu)
+	assertInstance.NoError(err)
+	assertInstance.Equal(domain.MediumDifficulty, difficulty)
+
+	mockMenu.On("RunMenu").Return(0, nil).Once()
+	difficulty, err = infrastructure.ChooseMatchDifficulty(domain.DefaultDifficultyLevels, mockMenu)
+	assertInstance.NoError(err)
+	assertInstance.Equal(domain.UnknownDifficulty, difficulty)
+
+	mockMenu.On("RunMenu").Return(4, nil).Once()
+	difficulty, err = infrastructure.ChooseMatchDifficulty(domain.DefaultDifficultyLevels, mockMenu)
+	assertInstance.NoError(err)
+	assertInstance.Equal(domain.AdaptiveDifficulty, difficulty)
+}
+
+func TestChooseMatchCategory(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	categories := []domain.Category{
+		{
+			Name: "Category1",
+		},
+		{
+			Name: "Category2",
+		},
+	}
+
+	assertInstance := assert.New(t)
+	mockMenu := &menuMocks.MenuProvider{}
+	mockMenu.On("AddItem", mock.Anything).Return()
+
+	mockMenu.On("RunMenu").Return(2, nil).Once()
+	category, err := infrastructure.ChooseMatchCategory(categories, mockMenu)
+	assertInstance.NoError(err)
+	assertInstance.Equal("Category2", category.Name)
+
+	mockMenu.On("RunMenu").Return(0, nil).Once()
+	category, err = infrastructure.ChooseMatchCategory(categories, mockMenu)
+	assertInstance.NoError(err)
+	assertInstance.Nil(category)
+}
+
+func TestSecretWordRandomizer(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	assertInstance := assert.New(t)
+	mockReader := &mocks.SecretReader{}
+
+	// Invalid words are rejected until a valid one is typed
+	mockReader.On("ReadSecret", mock.Anything).Return("snake_case", nil).Once()
+	mockReader.On("ReadSecret", mock.Anything).Return("   ", nil).Once()
+	mockReader.On("ReadSecret", mock.Anything).Return("Secret Word", nil).Once()
+	mockReader.On("ReadSecret", mock.Anything).Return("A hidden hint", nil).Once()
+	mockReader.On("ShowSecretError", mock.Anything).Return().Twice()
+
+	randomizer := infrastructure.NewSecretWordRandomizer(mockReader)
+
+	word, err := randomizer.ChoiceWord(nil, domain.UnknownDifficulty)
+	assertInstance.NoError(err)
+	assertInstance.Equal(&domain.Word{Word: "Secret Word", Hint: "A hidden hint"}, word)
+	mockReader.AssertExpectations(t)
+
+	mockReader.On("ReadSecret", mock.Anything).Return("", &climenu.ExitError{}).Once()
+
+	var exitErr *climenu.ExitError
+
+	_, err = randomizer.ChoiceWord(nil, domain.UnknownDifficulty)
+	assertInstance.ErrorAs(err, &exitErr)
+}
+
+func TestWriteSimulationReport(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	report := &application.SimulationReport{
+		Runs: 10,
+		Words: []application.WordStats{
+			{
+				Category: "Animals", Word: "cat", Declared: domain.EasyDifficulty, Measured: domain.HardDifficulty, MaxMistakes: 6,
+				Runs: 10, Wins: 10, WinRate: 1, AvgMistakes: 1.5, AvgAttempts: 4, Score: 0.25,
+			},
+		},
+		Categories: []application.CategoryStats{
+			{Category: "Animals", Words: 1, Runs: 10, WinRate: 1, AvgMistakes: 1.5, AvgAttempts: 4, Mismatches: 1},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		format   infrastructure.ReportFormat
+		expected string
+	}{
+		{
+			name:   "csv",
+			format: infrastructure.CSVReport,
+			expected: "kind,category,word,declared,measured,maxMistakes,runs,winRate,avgMistakes,avgAttempts,mismatches\n" +
+				"word,Animals,cat,easy,hard,6,10,1.000,1.500,4.000,1\n" +
+				"category,Animals,,,,,10,1.000,1.500,4.000,1\n",
+		},
+		{
+			name:   "json",
+			format: infrastructure.JSONReport,
+			expected: `{"runs":10,` +
+				`"words":[{"category":"Animals","word":"cat","declared":"easy","measured":"hard","maxMistakes":6,"mismatch":true,` +
+				`"runs":10,"winRate":1,"avgMistakes":1.5,"avgAttempts":4,"score":0.25}],` +
+				`"categories":[{"category":"Animals","words":1,"runs":10,"winRate":1,"avgMistakes":1.5,"avgAttempts":4,"mismatches":1}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+
+			err := infrastructure.WriteSimulationReport(&buffer, report, tt.format)
+			assert.NoError(t, err)
+
+			if tt.format == infrastructure.JSONReport {
+				assert.JSONEq(t, tt.expected, buffer.String())
+			} else {
+				assert.Equal(t, tt.expected, buffer.String())
+			}
+		})
+	}
+}
+
+func TestReportFormatSet(t *testing.T) {
+	var format infrastructure.ReportFormat
+
+	assert.NoError(t, format.Set("csv"))
+	assert.Equal(t, infrastructure.CSVReport, format)
+	assert.NoError(t, format.Set("json"))
+	assert.Equal(t, infrastructure.JSONReport, format)
+	assert.Error(t, format.Set("xml"))
 }
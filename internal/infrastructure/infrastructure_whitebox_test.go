@@ -5,11 +5,16 @@ package infrastructure //nolint
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"errors"
 	"io"
 	"log"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"makly/hangman/internal/domain"
 )
 
 func TestGetLetter(t *testing.T) {
@@ -132,7 +137,7 @@ func TestGetLetter(t *testing.T) {
 	}
 
 	assertInstance := assert.New(t)
-	consoleInput := NewConsoleInput()
+	consoleInput := NewConsoleInput(domain.LatinAlphabet)
 
 	for _, tt := range tests {
 		consoleInput.scanner = bufio.NewScanner(bytes.NewReader([]byte(tt.input + "\n")))
@@ -146,3 +151,202 @@ func TestGetLetter(t *testing.T) {
 		}
 	}
 }
+
+func TestGetGuess(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	tests := []struct {
+		name          string
+		input         string
+		alphabet      *domain.Alphabet
+		expectedGuess *domain.Guess
+		expectError   bool
+	}{
+		{
+			name:          "single letter",
+			input:         "a",
+			expectedGuess: domain.NewLetterGuess('a'),
+			expectError:   false,
+		},
+		{
+			name:          "single uppercase letter",
+			input:         "Q",
+			expectedGuess: domain.NewLetterGuess('q'),
+			expectError:   false,
+		},
+		{
+			name:          "whole word",
+			input:         "Apple",
+			expectedGuess: domain.NewWordGuess("apple"),
+			expectError:   false,
+		},
+		{
+			name:          "whole phrase",
+			input:         "hello world",
+			expectedGuess: domain.NewWordGuess("hello world"),
+			expectError:   false,
+		},
+		{
+			name:          "phrase with revealed characters",
+			input:         "Rock 'n' Roll",
+			expectedGuess: domain.NewWordGuess("rock 'n' roll"),
+			expectError:   false,
+		},
+		{
+			name:          "letter with trailing space",
+			input:         "a ",
+			expectedGuess: domain.NewLetterGuess('a'),
+			expectError:   false,
+		},
+		{
+			name:          "letter with leading space",
+			input:         "  Q",
+			expectedGuess: domain.NewLetterGuess('q'),
+			expectError:   false,
+		},
+		{
+			name:          "word with spaces around",
+			input:         " apple ",
+			expectedGuess: domain.NewWordGuess("apple"),
+			expectError:   false,
+		},
+		{
+			name:          "phrase with doubled spaces",
+			input:         "hello  \tworld",
+			expectedGuess: domain.NewWordGuess("hello world"),
+			expectError:   false,
+		},
+		{
+			name:          "hint request",
+			input:         " ? ",
+			expectedGuess: domain.NewHintRequest(),
+			expectError:   false,
+		},
+		{
+			name:          "undo request",
+			input:         "<",
+			expectedGuess: domain.NewUndoRequest(),
+			expectError:   false,
+		},
+		{
+			name:          "letter purchase",
+			input:         "+",
+			expectedGuess: domain.NewLetterPurchase(),
+			expectError:   false,
+		},
+		{
+			name:          "invalid letter",
+			input:         "1",
+			expectedGuess: nil,
+			expectError:   true,
+		},
+		{
+			name:          "invalid word",
+			input:         "apple_pie",
+			expectedGuess: nil,
+			expectError:   true,
+		},
+		{
+			name:          "invalid word - Russian",
+			input:         "яблоко",
+			expectedGuess: nil,
+			expectError:   true,
+		},
+		{
+			name:          "russian letter",
+			input:         "Ё",
+			alphabet:      domain.RussianAlphabet,
+			expectedGuess: domain.NewLetterGuess('ё'),
+			expectError:   false,
+		},
+		{
+			name:          "russian phrase",
+			input:         "Красная площадь",
+			alphabet:      domain.RussianAlphabet,
+			expectedGuess: domain.NewWordGuess("красная площадь"),
+			expectError:   false,
+		},
+		{
+			name:          "latin letter in russian alphabet",
+			input:         "a",
+			alphabet:      domain.RussianAlphabet,
+			expectedGuess: nil,
+			expectError:   true,
+		},
+		{
+			name:          "german word",
+			input:         "Straße",
+			alphabet:      domain.GermanAlphabet,
+			expectedGuess: domain.NewWordGuess("straße"),
+			expectError:   false,
+		},
+		{
+			name:          "empty input",
+			input:         "",
+			expectedGuess: nil,
+			expectError:   true,
+		},
+		{
+			name:          "only spaces",
+			input:         "   ",
+			expectedGuess: nil,
+			expectError:   true,
+		},
+	}
+
+	assertInstance := assert.New(t)
+
+	for _, tt := range tests {
+		consoleInput := NewConsoleInput(tt.alphabet)
+		consoleInput.scanner = bufio.NewScanner(bytes.NewReader([]byte(tt.input + "\n")))
+		guess, err := consoleInput.GetGuess()
+
+		if tt.expectError {
+			assertInstance.Error(err, tt.name)
+		} else {
+			assertInstance.NoError(err, tt.name)
+			assertInstance.Equal(tt.expectedGuess, guess, tt.name)
+		}
+	}
+}
+
+func TestGetGuessContext(t *testing.T) {
+	log.SetOutput(io.Discard)
+
+	reader, writer := io.Pipe()
+	consoleInput := NewConsoleInput(nil)
+	consoleInput.scanner = bufio.NewScanner(reader)
+
+	go func() {
+		_, _ = writer.Write([]byte("a\n"))
+	}()
+
+	guess, err := consoleInput.GetGuessContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, domain.NewLetterGuess('a'), guess)
+
+	timeout := errors.New("timeout")
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(timeout)
+
+	_, err = consoleInput.GetGuessContext(ctx)
+	assert.ErrorIs(t, err, timeout)
+
+	// The line typed after the time is over does not become the next guess
+	_, err = writer.Write([]byte("late\n"))
+	assert.NoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_, _ = writer.Write([]byte("b\n"))
+		_ = writer.Close()
+	}()
+
+	guess, err = consoleInput.GetGuess()
+	assert.NoError(t, err)
+	assert.Equal(t, domain.NewLetterGuess('b'), guess)
+
+	_, err = consoleInput.GetGuess()
+	assert.ErrorIs(t, err, io.EOF)
+}
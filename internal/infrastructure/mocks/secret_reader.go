@@ -0,0 +1,121 @@
+// Code generated by mockery v2.46.0. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// SecretReader is an autogenerated mock type for the SecretReader type
+type SecretReader struct {
+	mock.Mock
+}
+
+type SecretReader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SecretReader) EXPECT() *SecretReader_Expecter {
+	return &SecretReader_Expecter{mock: &_m.Mock}
+}
+
+// ReadSecret provides a mock function with given fields: prompt
+func (_m *SecretReader) ReadSecret(prompt string) (string, error) {
+	ret := _m.Called(prompt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadSecret")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(prompt)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(prompt)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(prompt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretReader_ReadSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadSecret'
+type SecretReader_ReadSecret_Call struct {
+	*mock.Call
+}
+
+// ReadSecret is a helper method to define mock.On call
+//   - prompt string
+func (_e *SecretReader_Expecter) ReadSecret(prompt interface{}) *SecretReader_ReadSecret_Call {
+	return &SecretReader_ReadSecret_Call{Call: _e.mock.On("ReadSecret", prompt)}
+}
+
+func (_c *SecretReader_ReadSecret_Call) Run(run func(prompt string)) *SecretReader_ReadSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *SecretReader_ReadSecret_Call) Return(secret string, err error) *SecretReader_ReadSecret_Call {
+	_c.Call.Return(secret, err)
+	return _c
+}
+
+func (_c *SecretReader_ReadSecret_Call) RunAndReturn(run func(string) (string, error)) *SecretReader_ReadSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ShowSecretError provides a mock function with given fields: err
+func (_m *SecretReader) ShowSecretError(err error) {
+	_m.Called(err)
+}
+
+// SecretReader_ShowSecretError_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowSecretError'
+type SecretReader_ShowSecretError_Call struct {
+	*mock.Call
+}
+
+// ShowSecretError is a helper method to define mock.On call
+//   - err error
+func (_e *SecretReader_Expecter) ShowSecretError(err interface{}) *SecretReader_ShowSecretError_Call {
+	return &SecretReader_ShowSecretError_Call{Call: _e.mock.On("ShowSecretError", err)}
+}
+
+func (_c *SecretReader_ShowSecretError_Call) Run(run func(err error)) *SecretReader_ShowSecretError_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(error))
+	})
+	return _c
+}
+
+func (_c *SecretReader_ShowSecretError_Call) Return() *SecretReader_ShowSecretError_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SecretReader_ShowSecretError_Call) RunAndReturn(run func(error)) *SecretReader_ShowSecretError_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSecretReader creates a new instance of SecretReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSecretReader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SecretReader {
+	mock := &SecretReader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,137 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"makly/hangman/internal/domain"
+)
+
+// ProfilesDir is the directory of the profiles inside the user config directory.
+const ProfilesDir = "hangman/profiles"
+
+type ProfileStore struct {
+	name string
+	path string
+}
+
+// NewProfileStore keeps the profile in the user config directory, e.g. ~/.config/hangman/profiles/default.json.
+func NewProfileStore(name string) (*ProfileStore, error) {
+	if err := domain.ValidateProfileName(name); err != nil {
+		return nil, err
+	}
+
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("get user config directory: %w", err)
+	}
+
+	return NewProfileStoreAt(name, filepath.Join(configDir, ProfilesDir)), nil
+}
+
+func NewProfileStoreAt(name, dir string) *ProfileStore {
+	return &ProfileStore{name: name, path: filepath.Join(dir, name+".json")}
+}
+
+// Other is the profile of another player in the same directory.
+func (p *ProfileStore) Other(name string) (*ProfileStore, error) {
+	if err := domain.ValidateProfileName(name); err != nil {
+		return nil, err
+	}
+
+	return NewProfileStoreAt(name, filepath.Dir(p.path)), nil
+}
+
+// LoadProfile returns an empty profile when there is no profile file yet.
+func (p *ProfileStore) LoadProfile() (profile *domain.Profile, err error) {
+	jsonBytes, err := os.ReadFile(p.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &domain.Profile{Name: p.name}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("read profile: %w", err)
+	}
+
+	var profileJSON domain.ProfileJSON
+
+	if err := json.Unmarshal(jsonBytes, &profileJSON); err != nil {
+		return nil, fmt.Errorf("unmarshal profile: %w", err)
+	}
+
+	profile, err = profileJSON.ToDomain()
+	if err != nil {
+		return nil, fmt.Errorf("convert profile to domain: %w", err)
+	}
+
+	slog.Info("Profile loaded", slog.String("path", p.path), slog.Any("profile", profile))
+
+	return profile, nil
+}
+
+func (p *ProfileStore) AddGame(result *domain.GameResult) (err error) {
+	if err := p.update(func(profile *domain.Profile) { profile.AddGame(result) }); err != nil {
+		return err
+	}
+
+	slog.Info("Game added to profile", slog.String("path", p.path), slog.Any("result", result))
+
+	return nil
+}
+
+func (p *ProfileStore) LoadWordHistory(collection string) (history *domain.WordHistory, err error) {
+	profile, err := p.LoadProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	return profile.WordHistory(collection), nil
+}
+
+func (p *ProfileStore) SaveWordHistory(collection string, history *domain.WordHistory) (err error) {
+	if err := p.update(func(profile *domain.Profile) { profile.SetWordHistory(collection, history) }); err != nil {
+		return err
+	}
+
+	slog.Info("Word history saved", slog.String("path", p.path), slog.String("collection", collection))
+
+	return nil
+}
+
+func (p *ProfileStore) LoadDailyResult(date, collection string) (result *domain.DailyResult, err error) {
+	profile, err := p.LoadProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	return profile.DailyResult(date, collection), nil
+}
+
+func (p *ProfileStore) SaveDailyResult(result *domain.DailyResult) (err error) {
+	if err := p.update(func(profile *domain.Profile) { profile.SetDailyResult(result) }); err != nil {
+		return err
+	}
+
+	slog.Info("Daily result saved", slog.String("path", p.path), slog.Any("result", result))
+
+	return nil
+}
+
+// update changes the profile kept in the file, so changes made by other sessions are not lost.
+func (p *ProfileStore) update(change func(profile *domain.Profile)) (err error) {
+	profile, err := p.LoadProfile()
+	if err != nil {
+		return err
+	}
+
+	change(profile)
+
+	if err := writeJSONFile(p.path, profile.ToJSON()); err != nil {
+		return fmt.Errorf("write profile: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package infrastructure
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+
+	"makly/hangman/internal/application"
+)
+
+type ReportFormat int
+
+const (
+	JSONReport ReportFormat = iota
+	CSVReport
+)
+
+func (f ReportFormat) String() string {
+	return [...]string{"json", "csv"}[f]
+}
+
+func (f *ReportFormat) Set(value string) error {
+	switch value {
+	case "json":
+		*f = JSONReport
+	case "csv":
+		*f = CSVReport
+	default:
+		return fmt.Errorf("unknown report format %q", value)
+	}
+
+	return nil
+}
+
+type wordStatsJSON struct {
+	Category    string  `json:"category"`
+	Word        string  `json:"word"`
+	Declared    string  `json:"declared"`
+	Measured    string  `json:"measured"`
+	MaxMistakes int     `json:"maxMistakes"`
+	Mismatch    bool    `json:"mismatch"`
+	Runs        int     `json:"runs"`
+	WinRate     float64 `json:"winRate"`
+	AvgMistakes float64 `json:"avgMistakes"`
+	AvgAttempts float64 `json:"avgAttempts"`
+	Score       float64 `json:"score"`
+}
+
+type categoryStatsJSON struct {
+	Category    string  `json:"category"`
+	Words       int     `json:"words"`
+	Runs        int     `json:"runs"`
+	WinRate     float64 `json:"winRate"`
+	AvgMistakes float64 `json:"avgMistakes"`
+	AvgAttempts float64 `json:"avgAttempts"`
+	Mismatches  int     `json:"mismatches"`
+}
+
+type simulationReportJSON struct {
+	Runs       int                 `json:"runs"`
+	Words      []wordStatsJSON     `json:"words"`
+	Categories []categoryStatsJSON `json:"categories"`
+}
+
+var simulationCSVHeader = []string{
+	"kind", "category", "word", "declared", "measured", "maxMistakes", "runs", "winRate", "avgMistakes", "avgAttempts", "mismatches",
+}
+
+func WriteSimulationReport(writer io.Writer, report *application.SimulationReport, format ReportFormat) (err error) {
+	switch format {
+	case JSONReport:
+		err = writeSimulationJSON(writer, report)
+	case CSVReport:
+		err = writeSimulationCSV(writer, report)
+	}
+
+	if err != nil {
+		return fmt.Errorf("write %s simulation report: %w", format, err)
+	}
+
+	slog.Info("Simulation report written", slog.String("format", format.String()), slog.Int("words", len(report.Words)))
+
+	return nil
+}
+
+func writeSimulationJSON(writer io.Writer, report *application.SimulationReport) error {
+	reportJSON := simulationReportJSON{
+		Runs:       report.Runs,
+		Words:      make([]wordStatsJSON, 0, len(report.Words)),
+		Categories: make([]categoryStatsJSON, 0, len(report.Categories)),
+	}
+
+	for _, word := range report.Words {
+		reportJSON.Words = append(reportJSON.Words, wordStatsJSON{
+			Category:    word.Category,
+			Word:        word.Word,
+			Declared:    report.Levels.Name(word.Declared),
+			Measured:    report.Levels.Name(word.Measured),
+			MaxMistakes: word.MaxMistakes,
+			Mismatch:    word.IsMismatch(),
+			Runs:        word.Runs,
+			WinRate:     word.WinRate,
+			AvgMistakes: word.AvgMistakes,
+			AvgAttempts: word.AvgAttempts,
+			Score:       word.Score,
+		})
+	}
+
+	for _, category := range report.Categories {
+		reportJSON.Categories = append(reportJSON.Categories, categoryStatsJSON(category))
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "    ")
+
+	return encoder.Encode(reportJSON)
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', 3, 64)
+}
+
+func writeSimulationCSV(writer io.Writer, report *application.SimulationReport) error {
+	csvWriter := csv.NewWriter(writer)
+	rows := [][]string{simulationCSVHeader}
+
+	for _, word := range report.Words {
+		mismatches := 0
+		if word.IsMismatch() {
+			mismatches = 1
+		}
+
+		rows = append(rows, []string{
+			"word", word.Category, word.Word,
+			report.Levels.Name(word.Declared), report.Levels.Name(word.Measured), strconv.Itoa(word.MaxMistakes),
+			strconv.Itoa(word.Runs), formatFloat(word.WinRate), formatFloat(word.AvgMistakes), formatFloat(word.AvgAttempts),
+			strconv.Itoa(mismatches),
+		})
+	}
+
+	for _, category := range report.Categories {
+		rows = append(rows, []string{
+			"category", category.Category, "", "", "", "",
+			strconv.Itoa(category.Runs), formatFloat(category.WinRate), formatFloat(category.AvgMistakes),
+			formatFloat(category.AvgAttempts), strconv.Itoa(category.Mismatches),
+		})
+	}
+
+	return csvWriter.WriteAll(rows)
+}